@@ -0,0 +1,443 @@
+// Package abi encodes Solidity function calls for EVM and TVM contracts.
+// It supports the elementary types (address, bool, intN, uintN, bytesN,
+// bytes, string) and fixed or dynamic arrays of them; tuples are not
+// supported.
+package abi
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/paratro/paratro-sdk-go/address"
+	"github.com/paratro/paratro-sdk-go/internal/keccak"
+)
+
+// Common token method signatures, shared by ERC-20 and TRC-20
+const (
+	SigTransfer     = "transfer(address,uint256)"
+	SigApprove      = "approve(address,uint256)"
+	SigTransferFrom = "transferFrom(address,address,uint256)"
+)
+
+// ErrInvalidSignature is returned for malformed method signatures
+var ErrInvalidSignature = errors.New("invalid method signature")
+
+// ErrInvalidArgument is returned when an argument does not fit its type
+var ErrInvalidArgument = errors.New("invalid ABI argument")
+
+// Method is a parsed function signature
+type Method struct {
+	Name   string
+	Inputs []string // Canonical type names
+}
+
+// ParseMethod parses a signature such as "transfer(address,uint)"
+func ParseMethod(signature string) (*Method, error) {
+	signature = strings.ReplaceAll(signature, " ", "")
+	open := strings.IndexByte(signature, '(')
+	if open < 1 || !strings.HasSuffix(signature, ")") {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidSignature, signature)
+	}
+
+	m := &Method{Name: signature[:open]}
+	params := signature[open+1 : len(signature)-1]
+	if params == "" {
+		return m, nil
+	}
+
+	for _, p := range strings.Split(params, ",") {
+		t, err := parseType(p)
+		if err != nil {
+			return nil, err
+		}
+		m.Inputs = append(m.Inputs, t.String())
+	}
+	return m, nil
+}
+
+// Signature returns the canonical signature used to derive the selector
+func (m *Method) Signature() string {
+	return m.Name + "(" + strings.Join(m.Inputs, ",") + ")"
+}
+
+// Selector returns the first four bytes of the Keccak-256 of the signature
+func (m *Method) Selector() [4]byte {
+	hash := keccak.Sum256([]byte(m.Signature()))
+	var sel [4]byte
+	copy(sel[:], hash[:4])
+	return sel
+}
+
+// Encode returns the selector followed by the encoded arguments
+func (m *Method) Encode(args ...interface{}) ([]byte, error) {
+	if len(args) != len(m.Inputs) {
+		return nil, fmt.Errorf("%w: %s takes %d arguments, got %d", ErrInvalidArgument, m.Signature(), len(m.Inputs), len(args))
+	}
+
+	types := make([]*abiType, len(m.Inputs))
+	for i, in := range m.Inputs {
+		t, err := parseType(in)
+		if err != nil {
+			return nil, err
+		}
+		types[i] = t
+	}
+
+	body, err := encodeTuple(types, args)
+	if err != nil {
+		return nil, err
+	}
+	sel := m.Selector()
+	return append(sel[:], body...), nil
+}
+
+// EncodeCall parses a signature and encodes a call with the given arguments
+func EncodeCall(signature string, args ...interface{}) ([]byte, error) {
+	m, err := ParseMethod(signature)
+	if err != nil {
+		return nil, err
+	}
+	return m.Encode(args...)
+}
+
+// EncodeCallHex is like EncodeCall but returns 0x-prefixed hex
+func EncodeCallHex(signature string, args ...interface{}) (string, error) {
+	data, err := EncodeCall(signature, args...)
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// EncodeArguments encodes values of the given types without a selector, as
+// used for constructor arguments and EIP-712 atomic values
+func EncodeArguments(types []string, values []interface{}) ([]byte, error) {
+	if len(types) != len(values) {
+		return nil, fmt.Errorf("%w: %d types for %d values", ErrInvalidArgument, len(types), len(values))
+	}
+
+	parsed := make([]*abiType, len(types))
+	for i, typ := range types {
+		t, err := parseType(strings.ReplaceAll(typ, " ", ""))
+		if err != nil {
+			return nil, err
+		}
+		parsed[i] = t
+	}
+	return encodeTuple(parsed, values)
+}
+
+// ============ Types ============
+
+type abiType struct {
+	kind   string // address, bool, int, uint, fixedbytes, bytes, string, array
+	size   int    // bit size for int/uint, byte size for fixedbytes, length for fixed arrays (-1 dynamic)
+	elem   *abiType
+	source string
+}
+
+func (t *abiType) String() string {
+	return t.source
+}
+
+func (t *abiType) dynamic() bool {
+	switch t.kind {
+	case "bytes", "string":
+		return true
+	case "array":
+		return t.size < 0 || t.elem.dynamic()
+	}
+	return false
+}
+
+func parseType(s string) (*abiType, error) {
+	if strings.HasSuffix(s, "]") {
+		open := strings.LastIndexByte(s, '[')
+		if open < 0 {
+			return nil, fmt.Errorf("%w: bad type %q", ErrInvalidSignature, s)
+		}
+		elem, err := parseType(s[:open])
+		if err != nil {
+			return nil, err
+		}
+
+		size := -1
+		if n := s[open+1 : len(s)-1]; n != "" {
+			size, err = strconv.Atoi(n)
+			if err != nil || size <= 0 {
+				return nil, fmt.Errorf("%w: bad array length in %q", ErrInvalidSignature, s)
+			}
+		}
+		return &abiType{kind: "array", size: size, elem: elem, source: elem.source + s[open:]}, nil
+	}
+
+	switch {
+	case s == "address", s == "bool", s == "string", s == "bytes":
+		return &abiType{kind: s, source: s}, nil
+	case s == "uint" || s == "int":
+		return &abiType{kind: s, size: 256, source: s + "256"}, nil
+	case strings.HasPrefix(s, "uint"), strings.HasPrefix(s, "int"):
+		kind := "uint"
+		if strings.HasPrefix(s, "int") {
+			kind = "int"
+		}
+		bits, err := strconv.Atoi(s[len(kind):])
+		if err != nil || bits < 8 || bits > 256 || bits%8 != 0 {
+			return nil, fmt.Errorf("%w: bad integer type %q", ErrInvalidSignature, s)
+		}
+		return &abiType{kind: kind, size: bits, source: s}, nil
+	case strings.HasPrefix(s, "bytes"):
+		n, err := strconv.Atoi(s[len("bytes"):])
+		if err != nil || n < 1 || n > 32 {
+			return nil, fmt.Errorf("%w: bad fixed bytes type %q", ErrInvalidSignature, s)
+		}
+		return &abiType{kind: "fixedbytes", size: n, source: s}, nil
+	}
+	return nil, fmt.Errorf("%w: unsupported type %q", ErrInvalidSignature, s)
+}
+
+// ============ Encoding ============
+
+// encodeTuple encodes values using the head/tail layout
+func encodeTuple(types []*abiType, values []interface{}) ([]byte, error) {
+	headSize := 0
+	for _, t := range types {
+		headSize += headWords(t) * 32
+	}
+
+	var head, tail []byte
+	for i, t := range types {
+		enc, err := encodeValue(t, values[i])
+		if err != nil {
+			return nil, fmt.Errorf("argument %d (%s): %w", i, t, err)
+		}
+		if t.dynamic() {
+			head = append(head, word(big.NewInt(int64(headSize+len(tail))))...)
+			tail = append(tail, enc...)
+		} else {
+			head = append(head, enc...)
+		}
+	}
+	return append(head, tail...), nil
+}
+
+// headWords is the number of head words a value of the type occupies
+func headWords(t *abiType) int {
+	if t.kind == "array" && !t.dynamic() {
+		return t.size * headWords(t.elem)
+	}
+	return 1
+}
+
+func encodeValue(t *abiType, v interface{}) ([]byte, error) {
+	switch t.kind {
+	case "address":
+		return encodeAddress(v)
+	case "bool":
+		b, ok := v.(bool)
+		if !ok {
+			return nil, fmt.Errorf("%w: expected bool, got %T", ErrInvalidArgument, v)
+		}
+		if b {
+			return word(big.NewInt(1)), nil
+		}
+		return word(new(big.Int)), nil
+	case "uint", "int":
+		return encodeInteger(t, v)
+	case "fixedbytes":
+		b, err := toBytes(v)
+		if err != nil {
+			return nil, err
+		}
+		if len(b) != t.size {
+			return nil, fmt.Errorf("%w: %s needs %d bytes, got %d", ErrInvalidArgument, t, t.size, len(b))
+		}
+		return padRight(b), nil
+	case "bytes":
+		b, err := toBytes(v)
+		if err != nil {
+			return nil, err
+		}
+		return append(word(big.NewInt(int64(len(b)))), padRight(b)...), nil
+	case "string":
+		s, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("%w: expected string, got %T", ErrInvalidArgument, v)
+		}
+		return append(word(big.NewInt(int64(len(s)))), padRight([]byte(s))...), nil
+	default:
+		return encodeArray(t, v)
+	}
+}
+
+func encodeArray(t *abiType, v interface{}) ([]byte, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return nil, fmt.Errorf("%w: expected slice for %s, got %T", ErrInvalidArgument, t, v)
+	}
+	if t.size >= 0 && rv.Len() != t.size {
+		return nil, fmt.Errorf("%w: %s needs %d elements, got %d", ErrInvalidArgument, t, t.size, rv.Len())
+	}
+
+	types := make([]*abiType, rv.Len())
+	values := make([]interface{}, rv.Len())
+	for i := range types {
+		types[i] = t.elem
+		values[i] = rv.Index(i).Interface()
+	}
+
+	body, err := encodeTuple(types, values)
+	if err != nil {
+		return nil, err
+	}
+	if t.size < 0 {
+		return append(word(big.NewInt(int64(rv.Len()))), body...), nil
+	}
+	return body, nil
+}
+
+// encodeAddress accepts EVM hex addresses, Tron base58 or 41-prefixed hex
+// addresses, and 20-byte slices
+func encodeAddress(v interface{}) ([]byte, error) {
+	var raw []byte
+	switch a := v.(type) {
+	case [20]byte:
+		raw = a[:]
+	case []byte:
+		raw = a
+	case string:
+		b, err := addressBytes(a)
+		if err != nil {
+			return nil, err
+		}
+		raw = b
+	default:
+		return nil, fmt.Errorf("%w: expected address, got %T", ErrInvalidArgument, v)
+	}
+
+	if len(raw) != 20 {
+		return nil, fmt.Errorf("%w: address must be 20 bytes, got %d", ErrInvalidArgument, len(raw))
+	}
+	return append(make([]byte, 12), raw...), nil
+}
+
+func addressBytes(s string) ([]byte, error) {
+	if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
+		if _, err := address.Parse("ETH", s); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidArgument, err)
+		}
+		return hex.DecodeString(s[2:])
+	}
+
+	tron, err := address.Parse("TRX", s)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidArgument, err)
+	}
+	return address.TronBytes(tron.Normalized)
+}
+
+func encodeInteger(t *abiType, v interface{}) ([]byte, error) {
+	n, err := toBigInt(v)
+	if err != nil {
+		return nil, err
+	}
+
+	if t.kind == "uint" {
+		if n.Sign() < 0 || n.BitLen() > t.size {
+			return nil, fmt.Errorf("%w: %s out of range for %s", ErrInvalidArgument, n, t)
+		}
+		return word(n), nil
+	}
+
+	limit := new(big.Int).Lsh(big.NewInt(1), uint(t.size-1))
+	if n.Cmp(limit) >= 0 || n.Cmp(new(big.Int).Neg(limit)) < 0 {
+		return nil, fmt.Errorf("%w: %s out of range for %s", ErrInvalidArgument, n, t)
+	}
+	if n.Sign() < 0 {
+		// Two's complement over 256 bits
+		n = new(big.Int).Add(n, new(big.Int).Lsh(big.NewInt(1), 256))
+	}
+	return word(n), nil
+}
+
+func toBigInt(v interface{}) (*big.Int, error) {
+	switch n := v.(type) {
+	case *big.Int:
+		if n == nil {
+			return nil, fmt.Errorf("%w: nil integer", ErrInvalidArgument)
+		}
+		return n, nil
+	case big.Int:
+		return &n, nil
+	case int:
+		return big.NewInt(int64(n)), nil
+	case int64:
+		return big.NewInt(n), nil
+	case uint64:
+		return new(big.Int).SetUint64(n), nil
+	case uint32:
+		return big.NewInt(int64(n)), nil
+	case float64:
+		// Numbers decoded from JSON into interface{} arrive as float64, which
+		// is exact only up to 2^53
+		if math.IsNaN(n) || math.IsInf(n, 0) {
+			return nil, fmt.Errorf("%w: %v is not an integer", ErrInvalidArgument, n)
+		}
+		if math.Abs(n) > 1<<53 {
+			return nil, fmt.Errorf("%w: %v may have lost precision as a float64, pass it as a string or json.Number",
+				ErrInvalidArgument, n)
+		}
+		b, acc := new(big.Float).SetFloat64(n).Int(nil)
+		if acc != big.Exact {
+			return nil, fmt.Errorf("%w: %v is not an integer", ErrInvalidArgument, n)
+		}
+		return b, nil
+	case json.Number:
+		return toBigInt(n.String())
+	case string:
+		b, ok := new(big.Int).SetString(n, 0)
+		if !ok {
+			return nil, fmt.Errorf("%w: %q is not an integer", ErrInvalidArgument, n)
+		}
+		return b, nil
+	}
+	return nil, fmt.Errorf("%w: expected integer, got %T", ErrInvalidArgument, v)
+}
+
+func toBytes(v interface{}) ([]byte, error) {
+	switch b := v.(type) {
+	case []byte:
+		return b, nil
+	case [32]byte:
+		return b[:], nil
+	case string:
+		raw, err := hex.DecodeString(strings.TrimPrefix(strings.TrimPrefix(b, "0x"), "0X"))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q is not hex", ErrInvalidArgument, b)
+		}
+		return raw, nil
+	}
+	return nil, fmt.Errorf("%w: expected bytes, got %T", ErrInvalidArgument, v)
+}
+
+// word left-pads a non-negative integer to 32 bytes
+func word(n *big.Int) []byte {
+	out := make([]byte, 32)
+	n.FillBytes(out)
+	return out
+}
+
+// padRight pads bytes with zeros to a multiple of 32
+func padRight(b []byte) []byte {
+	size := (len(b) + 31) / 32 * 32
+	out := make([]byte, size)
+	copy(out, b)
+	return out
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/paratro/paratro-sdk-go/address"
 	"github.com/paratro/paratro-sdk-go/common"
 )
 
@@ -20,26 +21,64 @@ func NewService(client *common.Client) *Service {
 
 // CreateAccountRequest represents a request to create a new account
 type CreateAccountRequest struct {
-	WalletID    string `json:"wallet_id"`
-	Chain       string `json:"chain"`
-	Network     string `json:"network"`
-	Label       string `json:"label,omitempty"`
-	AccountType string `json:"account_type,omitempty"` // EOA, etc.
+	WalletID    string            `json:"wallet_id"`
+	Chain       common.Chain      `json:"chain"`
+	Network     common.Network    `json:"network"`
+	Label       string            `json:"label,omitempty"`
+	AccountType string            `json:"account_type,omitempty"` // EOA, etc.
+	Metadata    map[string]string `json:"metadata,omitempty"`     // Arbitrary key/value tags
+}
+
+// Status is the lifecycle state of an account
+type Status string
+
+// Account statuses
+const (
+	StatusActive  Status = "ACTIVE"
+	StatusFrozen  Status = "FROZEN"
+	StatusDeleted Status = "DELETED"
+)
+
+var statuses = []string{string(StatusActive), string(StatusFrozen), string(StatusDeleted)}
+
+// ParseStatus returns the account status for s, ignoring case
+func ParseStatus(s string) (Status, error) {
+	v, err := common.ParseEnum("account status", s, statuses...)
+	return Status(v), err
+}
+
+// IsKnown reports whether s is one of the Status constants
+func (s Status) IsKnown() bool {
+	v, err := ParseStatus(string(s))
+	return err == nil && v == s
+}
+
+// UnmarshalJSON accepts any case and keeps unknown statuses as sent
+func (s *Status) UnmarshalJSON(data []byte) error {
+	v, err := common.UnmarshalEnum(data, statuses...)
+	*s = Status(v)
+	return err
 }
 
 // Account represents an account in a wallet
 type Account struct {
-	AccountID      string `json:"account_id"`
-	WalletID       string `json:"wallet_id"`
-	Address        string `json:"address"`
-	Chain          string `json:"chain"`
-	Network        string `json:"network"`
-	Label          string `json:"label"`
-	DerivationPath string `json:"derivation_path"`
-	AddressIndex   int    `json:"address_index"`
-	Status         string `json:"status"` // ACTIVE, FROZEN, DELETED
-	CreatedAt      string `json:"created_at"`
-	UpdatedAt      string `json:"updated_at,omitempty"`
+	AccountID      string            `json:"account_id"`
+	WalletID       string            `json:"wallet_id"`
+	Address        string            `json:"address"`
+	Chain          common.Chain      `json:"chain"`
+	Network        common.Network    `json:"network"`
+	Label          string            `json:"label"`
+	DerivationPath string            `json:"derivation_path"`
+	AddressIndex   int               `json:"address_index"`
+	Status         Status            `json:"status"`
+	Metadata       map[string]string `json:"metadata,omitempty"`
+	CreatedAt      common.Time       `json:"created_at"`
+	UpdatedAt      common.Time       `json:"updated_at"`
+}
+
+// ValidateAddress checks that the account address is well formed for its chain and network
+func (a *Account) ValidateAddress() error {
+	return address.Validate(string(a.Chain), string(a.Network), a.Address)
 }
 
 // Create creates a new account in a wallet
@@ -63,6 +102,66 @@ func (s *Service) Get(ctx context.Context, accountID string) (*Account, error) {
 	return &account, nil
 }
 
+// UpdateAccountRequest represents a request to update an account.
+// Empty fields are left unchanged; Metadata keys are merged, and a key
+// set to an empty value is removed.
+type UpdateAccountRequest struct {
+	Label    string            `json:"label,omitempty"`
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// Update updates the label and metadata of an account
+func (s *Service) Update(ctx context.Context, accountID string, req *UpdateAccountRequest) (*Account, error) {
+	var account Account
+	path := fmt.Sprintf("/api/v1/accounts/%s", accountID)
+	err := s.client.Request("PATCH", path, req, &account)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update account: %w", err)
+	}
+	return &account, nil
+}
+
+// Relabel changes the label of an account
+func (s *Service) Relabel(ctx context.Context, accountID, label string) (*Account, error) {
+	if label == "" {
+		return nil, fmt.Errorf("label is required")
+	}
+	return s.Update(ctx, accountID, &UpdateAccountRequest{Label: label})
+}
+
+// Freeze freezes an account, blocking outgoing transactions
+func (s *Service) Freeze(ctx context.Context, accountID string) (*Account, error) {
+	var account Account
+	path := fmt.Sprintf("/api/v1/accounts/%s/freeze", accountID)
+	err := s.client.Request("POST", path, nil, &account)
+	if err != nil {
+		return nil, fmt.Errorf("failed to freeze account: %w", err)
+	}
+	return &account, nil
+}
+
+// Unfreeze reactivates a frozen account
+func (s *Service) Unfreeze(ctx context.Context, accountID string) (*Account, error) {
+	var account Account
+	path := fmt.Sprintf("/api/v1/accounts/%s/unfreeze", accountID)
+	err := s.client.Request("POST", path, nil, &account)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unfreeze account: %w", err)
+	}
+	return &account, nil
+}
+
+// Delete deletes an account and returns it with status DELETED
+func (s *Service) Delete(ctx context.Context, accountID string) (*Account, error) {
+	var account Account
+	path := fmt.Sprintf("/api/v1/accounts/%s", accountID)
+	err := s.client.Request("DELETE", path, nil, &account)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete account: %w", err)
+	}
+	return &account, nil
+}
+
 // ListAccountsRequest represents a request to list accounts
 type ListAccountsRequest struct {
 	WalletID string `json:"wallet_id,omitempty"` // Filter by wallet ID
@@ -102,3 +201,20 @@ func (s *Service) List(ctx context.Context, req *ListAccountsRequest) (*ListAcco
 	}
 	return &response, nil
 }
+
+// ListAll retrieves every page of accounts matching req, ignoring its Page
+// and PageSize
+func (s *Service) ListAll(ctx context.Context, req *ListAccountsRequest) ([]Account, error) {
+	filter := ListAccountsRequest{}
+	if req != nil {
+		filter = *req
+	}
+	return common.ListAll(common.DefaultPageSize, func(page, pageSize int) ([]Account, error) {
+		filter.Page, filter.PageSize = page, pageSize
+		resp, err := s.List(ctx, &filter)
+		if err != nil {
+			return nil, err
+		}
+		return resp.Items, nil
+	})
+}
@@ -0,0 +1,235 @@
+package account
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/paratro/paratro-sdk-go/address"
+	"github.com/paratro/paratro-sdk-go/common"
+)
+
+// LabelIndexPlaceholder is replaced by the item index in a batch label template
+const LabelIndexPlaceholder = "{index}"
+
+// BatchCreateAccountsRequest represents a request to create many accounts in a wallet
+type BatchCreateAccountsRequest struct {
+	WalletID    string
+	Chain       common.Chain
+	Network     common.Network
+	AccountType string // EOA, etc.
+
+	// LabelTemplate is applied to every account, with LabelIndexPlaceholder
+	// replaced by the item index (e.g. "deposit-{index}")
+	LabelTemplate string
+
+	// Count is the number of accounts to create
+	Count int
+
+	// StartIndex is the index of the first item in this run. Item indexes
+	// number labels only; they are unrelated to the accounts' AddressIndex.
+	StartIndex int
+
+	// Resume starts after the highest item index found in the labels of the
+	// wallet's accounts on Chain and Network, so a run that was interrupted
+	// can be restarted without relabelling. It requires a LabelTemplate
+	// containing LabelIndexPlaceholder; StartIndex is used if no label matches.
+	Resume bool
+
+	// Concurrency limits the number of in-flight requests (default 4)
+	Concurrency int
+
+	// RequestsPerSecond limits the request rate; zero means unlimited
+	RequestsPerSecond float64
+}
+
+// BatchAccountResult is the outcome of a single item in a batch
+type BatchAccountResult struct {
+	Index   int
+	Label   string
+	Account *Account
+	Err     error
+}
+
+// BatchCreateAccountsResult holds the per-item results of a batch
+type BatchCreateAccountsResult struct {
+	Items     []BatchAccountResult
+	Succeeded int
+	Failed    int
+
+	// NextIndex is the item index a follow-up run should start from
+	NextIndex int
+}
+
+// FailedItems returns the items that could not be created
+func (r *BatchCreateAccountsResult) FailedItems() []BatchAccountResult {
+	var failed []BatchAccountResult
+	for _, item := range r.Items {
+		if item.Err != nil {
+			failed = append(failed, item)
+		}
+	}
+	return failed
+}
+
+// BatchCreate creates req.Count accounts concurrently. Failures of individual
+// items are reported in the result rather than aborting the batch.
+func (s *Service) BatchCreate(ctx context.Context, req *BatchCreateAccountsRequest) (*BatchCreateAccountsResult, error) {
+	if req == nil {
+		return nil, fmt.Errorf("batch request is required")
+	}
+	if req.WalletID == "" {
+		return nil, fmt.Errorf("wallet_id is required")
+	}
+	if req.Count <= 0 {
+		return nil, fmt.Errorf("count must be positive")
+	}
+
+	start := req.StartIndex
+	if req.Resume {
+		next, err := s.nextLabelIndex(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resume batch: %w", err)
+		}
+		start = next
+	}
+
+	concurrency := req.Concurrency
+	if concurrency <= 0 {
+		concurrency = 4
+	}
+
+	var throttle <-chan time.Time
+	if req.RequestsPerSecond > 0 {
+		ticker := time.NewTicker(time.Duration(float64(time.Second) / req.RequestsPerSecond))
+		defer ticker.Stop()
+		throttle = ticker.C
+	}
+
+	result := &BatchCreateAccountsResult{
+		Items:     make([]BatchAccountResult, req.Count),
+		NextIndex: start + req.Count,
+	}
+
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	for i := 0; i < req.Count; i++ {
+		index := start + i
+		item := &result.Items[i]
+		item.Index = index
+		item.Label = expandLabel(req.LabelTemplate, index)
+
+		if err := waitTurn(ctx, sem, throttle); err != nil {
+			item.Err = err
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			item.Account, item.Err = s.Create(ctx, &CreateAccountRequest{
+				WalletID:    req.WalletID,
+				Chain:       req.Chain,
+				Network:     req.Network,
+				Label:       item.Label,
+				AccountType: req.AccountType,
+			})
+		}()
+	}
+	wg.Wait()
+
+	for _, item := range result.Items {
+		if item.Err != nil {
+			result.Failed++
+		} else {
+			result.Succeeded++
+		}
+	}
+
+	return result, nil
+}
+
+// nextLabelIndex returns the item index following the highest one in the
+// labels of the wallet's accounts, or req.StartIndex if no label matches
+func (s *Service) nextLabelIndex(ctx context.Context, req *BatchCreateAccountsRequest) (int, error) {
+	if !strings.Contains(req.LabelTemplate, LabelIndexPlaceholder) {
+		return 0, fmt.Errorf("label template must contain %s", LabelIndexPlaceholder)
+	}
+
+	accounts, err := s.ListAll(ctx, &ListAccountsRequest{WalletID: req.WalletID})
+	if err != nil {
+		return 0, err
+	}
+
+	next := req.StartIndex
+	for i := range accounts {
+		a := &accounts[i]
+		if req.Chain != "" && address.CanonicalChain(string(a.Chain)) != address.CanonicalChain(string(req.Chain)) {
+			continue
+		}
+		if req.Network != "" && !strings.EqualFold(string(a.Network), string(req.Network)) {
+			continue
+		}
+		if index, ok := labelIndex(req.LabelTemplate, a.Label); ok && index >= next {
+			next = index + 1
+		}
+	}
+	return next, nil
+}
+
+// NextAddressIndex returns the address index following the highest one
+// issued in a wallet for the given chain and network. Empty chain or network
+// match any value.
+func (s *Service) NextAddressIndex(ctx context.Context, walletID string, chain common.Chain, network common.Network) (int, error) {
+	issued, err := s.IssuedAddresses(ctx, walletID, chain, network)
+	if err != nil {
+		return 0, err
+	}
+	return issued.NextIndex, nil
+}
+
+// waitTurn blocks until a concurrency slot and, if set, a rate limit tick are available
+func waitTurn(ctx context.Context, sem chan struct{}, throttle <-chan time.Time) error {
+	if throttle != nil {
+		select {
+		case <-throttle:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	select {
+	case sem <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// expandLabel substitutes the item index into a label template
+func expandLabel(template string, index int) string {
+	return strings.ReplaceAll(template, LabelIndexPlaceholder, strconv.Itoa(index))
+}
+
+// labelIndex recovers the item index from a label produced by expandLabel
+func labelIndex(template, label string) (int, bool) {
+	prefix := template[:strings.Index(template, LabelIndexPlaceholder)]
+	if !strings.HasPrefix(label, prefix) {
+		return 0, false
+	}
+	digits := strings.TrimPrefix(label, prefix)
+	if end := strings.IndexFunc(digits, func(r rune) bool { return r < '0' || r > '9' }); end >= 0 {
+		digits = digits[:end]
+	}
+	index, err := strconv.Atoi(digits)
+	if err != nil || expandLabel(template, index) != label {
+		return 0, false
+	}
+	return index, true
+}
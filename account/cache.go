@@ -0,0 +1,165 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/paratro/paratro-sdk-go/address"
+	"github.com/paratro/paratro-sdk-go/common"
+)
+
+// GetByAddress retrieves the account that owns an on-chain address. The
+// address is normalized for the chain before the lookup.
+func (s *Service) GetByAddress(ctx context.Context, chain common.Chain, addr string) (*Account, error) {
+	params := map[string]string{
+		"chain":   string(chain),
+		"address": normalizeAddress(chain, addr),
+	}
+
+	var account Account
+	err := s.client.RequestWithQuery("/api/v1/accounts/by-address", params, &account)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account by address: %w", err)
+	}
+	return &account, nil
+}
+
+// AddressCache is a local index from on-chain address to account. It can be
+// warmed from List pages, kept current by polling, and updated from webhook
+// handlers with Put and Remove.
+type AddressCache struct {
+	service  *Service
+	mu       sync.RWMutex
+	accounts map[string]*Account
+}
+
+// NewAddressCache creates an empty address cache backed by the account service
+func NewAddressCache(service *Service) *AddressCache {
+	return &AddressCache{
+		service:  service,
+		accounts: make(map[string]*Account),
+	}
+}
+
+// Put adds or replaces an account in the cache. Deleted accounts are removed.
+func (c *AddressCache) Put(account *Account) {
+	if account == nil || account.Address == "" {
+		return
+	}
+	if account.Status == StatusDeleted {
+		c.Remove(account.Chain, account.Address)
+		return
+	}
+
+	c.mu.Lock()
+	c.accounts[cacheKey(account.Chain, account.Address)] = account
+	c.mu.Unlock()
+}
+
+// Remove drops an address from the cache
+func (c *AddressCache) Remove(chain common.Chain, addr string) {
+	c.mu.Lock()
+	delete(c.accounts, cacheKey(chain, addr))
+	c.mu.Unlock()
+}
+
+// Lookup returns a cached account without calling the API
+func (c *AddressCache) Lookup(chain common.Chain, addr string) (*Account, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	account, ok := c.accounts[cacheKey(chain, addr)]
+	return account, ok
+}
+
+// Get returns a cached account, falling back to GetByAddress on a miss
+func (c *AddressCache) Get(ctx context.Context, chain common.Chain, addr string) (*Account, error) {
+	if account, ok := c.Lookup(chain, addr); ok {
+		return account, nil
+	}
+
+	account, err := c.service.GetByAddress(ctx, chain, addr)
+	if err != nil {
+		return nil, err
+	}
+	c.Put(account)
+	return account, nil
+}
+
+// Len returns the number of cached addresses
+func (c *AddressCache) Len() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return len(c.accounts)
+}
+
+// Warm pages through List with the given filter and caches every account.
+// It returns the number of accounts seen.
+func (c *AddressCache) Warm(ctx context.Context, req *ListAccountsRequest) (int, error) {
+	filter := ListAccountsRequest{PageSize: 100}
+	if req != nil {
+		filter = *req
+		if filter.PageSize <= 0 {
+			filter.PageSize = 100
+		}
+	}
+
+	seen := 0
+	for page := 1; ; page++ {
+		if err := ctx.Err(); err != nil {
+			return seen, err
+		}
+
+		filter.Page = page
+		resp, err := c.service.List(ctx, &filter)
+		if err != nil {
+			return seen, err
+		}
+
+		for i := range resp.Items {
+			c.Put(&resp.Items[i])
+		}
+		seen += len(resp.Items)
+
+		if len(resp.Items) < filter.PageSize || (resp.TotalPages > 0 && page >= resp.TotalPages) {
+			return seen, nil
+		}
+	}
+}
+
+// Poll re-warms the cache every interval until the context is cancelled.
+// Errors from individual refreshes are passed to onError, which may be nil.
+func (c *AddressCache) Poll(ctx context.Context, req *ListAccountsRequest, interval time.Duration, onError func(error)) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := c.Warm(ctx, req); err != nil && onError != nil && !errors.Is(err, ctx.Err()) {
+			onError(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// cacheKey builds the cache key from the canonical chain and normalized address
+func cacheKey(chain common.Chain, addr string) string {
+	return address.CanonicalChain(string(chain)) + ":" + normalizeAddress(chain, addr)
+}
+
+// normalizeAddress normalizes an address for its chain, falling back to the
+// trimmed input for chains the address package does not support
+func normalizeAddress(chain common.Chain, addr string) string {
+	normalized, err := address.Normalize(string(chain), addr)
+	if err != nil {
+		return strings.TrimSpace(addr)
+	}
+	return normalized
+}
@@ -0,0 +1,100 @@
+package account
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/paratro/paratro-sdk-go/address"
+	"github.com/paratro/paratro-sdk-go/common"
+	"github.com/paratro/paratro-sdk-go/derivation"
+)
+
+// ParsedDerivationPath parses the account's derivation path
+func (a *Account) ParsedDerivationPath() (derivation.Path, error) {
+	return derivation.Parse(a.DerivationPath)
+}
+
+// ValidateDerivationPath checks that the derivation path fits the account's
+// chain and that its index matches AddressIndex
+func (a *Account) ValidateDerivationPath() error {
+	path, err := a.ParsedDerivationPath()
+	if err != nil {
+		return err
+	}
+	if err := derivation.ValidateForChain(path, string(a.Chain), string(a.Network)); err != nil {
+		return err
+	}
+	if int(path.Index()) != a.AddressIndex {
+		return fmt.Errorf("%w: path index %d does not match address index %d",
+			derivation.ErrInvalidPath, path.Index(), a.AddressIndex)
+	}
+	return nil
+}
+
+// IssuedAddresses summarizes the address indexes issued in a wallet
+type IssuedAddresses struct {
+	Indexes   []int        // Issued address indexes in ascending order
+	Gaps      []IndexRange // Runs of unissued indexes below NextIndex
+	NextIndex int          // Index following the highest issued one
+	Invalid   []string     // Account IDs whose derivation path fails validation
+}
+
+// IndexRange is an inclusive range of address indexes
+type IndexRange struct {
+	From int
+	To   int
+}
+
+// Len returns the number of indexes in the range
+func (r IndexRange) Len() int {
+	return r.To - r.From + 1
+}
+
+// IssuedAddresses scans the accounts of a wallet for the given chain and
+// network and reports which address indexes have been issued. Empty chain or
+// network match any value.
+func (s *Service) IssuedAddresses(ctx context.Context, walletID string, chain common.Chain, network common.Network) (*IssuedAddresses, error) {
+	accounts, err := s.ListAll(ctx, &ListAccountsRequest{WalletID: walletID})
+	if err != nil {
+		return nil, err
+	}
+
+	issued := &IssuedAddresses{}
+	seen := make(map[int]bool)
+	for i := range accounts {
+		a := &accounts[i]
+		if chain != "" && address.CanonicalChain(string(a.Chain)) != address.CanonicalChain(string(chain)) {
+			continue
+		}
+		if network != "" && !strings.EqualFold(string(a.Network), string(network)) {
+			continue
+		}
+
+		if !seen[a.AddressIndex] {
+			seen[a.AddressIndex] = true
+			issued.Indexes = append(issued.Indexes, a.AddressIndex)
+		}
+		if a.DerivationPath != "" && a.ValidateDerivationPath() != nil {
+			issued.Invalid = append(issued.Invalid, a.AccountID)
+		}
+	}
+
+	sort.Ints(issued.Indexes)
+	if n := len(issued.Indexes); n > 0 {
+		issued.NextIndex = issued.Indexes[n-1] + 1
+	}
+	// Gaps are reported as ranges so a stray huge index cannot blow up the result
+	from := 0
+	for _, index := range issued.Indexes {
+		if index > from {
+			issued.Gaps = append(issued.Gaps, IndexRange{From: from, To: index - 1})
+		}
+		if index >= from {
+			from = index + 1
+		}
+	}
+
+	return issued, nil
+}
@@ -0,0 +1,333 @@
+// Package address validates and normalizes blockchain addresses for the
+// chains supported by the MPC Wallet Gateway.
+package address
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/paratro/paratro-sdk-go/internal/keccak"
+)
+
+var (
+	// ErrUnsupportedChain is returned for chains the SDK cannot validate
+	ErrUnsupportedChain = errors.New("unsupported chain")
+	// ErrInvalidAddress is returned for malformed addresses
+	ErrInvalidAddress = errors.New("invalid address")
+	// ErrInvalidChecksum is returned when an address checksum does not match
+	ErrInvalidChecksum = errors.New("invalid address checksum")
+	// ErrNetworkMismatch is returned when an address belongs to another network
+	ErrNetworkMismatch = errors.New("address network mismatch")
+)
+
+// Family groups chains that share an address format
+type Family string
+
+const (
+	FamilyEVM  Family = "EVM"
+	FamilyTron Family = "TRX"
+	FamilyBTC  Family = "BTC"
+)
+
+// Format describes the encoding of an address
+type Format string
+
+const (
+	FormatEIP55       Format = "EIP55"
+	FormatBase58Check Format = "BASE58CHECK"
+	FormatBech32      Format = "BECH32"
+	FormatBech32m     Format = "BECH32M"
+)
+
+// Network values detected from address encodings
+const (
+	NetworkMainnet = "mainnet"
+	NetworkTestnet = "testnet"
+	NetworkRegtest = "regtest"
+)
+
+// Address is a parsed and normalized address
+type Address struct {
+	Family     Family
+	Format     Format
+	Network    string // empty when the encoding does not identify the network
+	Normalized string
+}
+
+// String returns the normalized address
+func (a *Address) String() string {
+	return a.Normalized
+}
+
+var chainFamilies = map[string]Family{
+	"ETH":       FamilyEVM,
+	"ETHEREUM":  FamilyEVM,
+	"BSC":       FamilyEVM,
+	"BNB":       FamilyEVM,
+	"POLYGON":   FamilyEVM,
+	"MATIC":     FamilyEVM,
+	"ARB":       FamilyEVM,
+	"ARBITRUM":  FamilyEVM,
+	"OP":        FamilyEVM,
+	"OPTIMISM":  FamilyEVM,
+	"AVAX":      FamilyEVM,
+	"AVALANCHE": FamilyEVM,
+	"BASE":      FamilyEVM,
+	"TRX":       FamilyTron,
+	"TRON":      FamilyTron,
+	"BTC":       FamilyBTC,
+	"BITCOIN":   FamilyBTC,
+}
+
+// ChainFamily returns the address family of a chain identifier such as
+// "ETH", "ethereum" or "TRX"
+func ChainFamily(chain string) (Family, error) {
+	family, ok := chainFamilies[strings.ToUpper(strings.TrimSpace(chain))]
+	if !ok {
+		return "", fmt.Errorf("%w: %q", ErrUnsupportedChain, chain)
+	}
+	return family, nil
+}
+
+var chainAliases = map[string]string{
+	"ETHEREUM":  "ETH",
+	"BNB":       "BSC",
+	"MATIC":     "POLYGON",
+	"ARBITRUM":  "ARB",
+	"OPTIMISM":  "OP",
+	"AVALANCHE": "AVAX",
+	"TRON":      "TRX",
+	"BITCOIN":   "BTC",
+}
+
+// CanonicalChain returns the ticker form of a chain identifier, so that
+// "ethereum" and "ETH" compare equal. Unknown chains are upper-cased.
+func CanonicalChain(chain string) string {
+	c := strings.ToUpper(strings.TrimSpace(chain))
+	if alias, ok := chainAliases[c]; ok {
+		return alias
+	}
+	return c
+}
+
+// Parse validates an address for a chain and returns its normalized form
+func Parse(chain, addr string) (*Address, error) {
+	family, err := ChainFamily(chain)
+	if err != nil {
+		return nil, err
+	}
+
+	addr = strings.TrimSpace(addr)
+	switch family {
+	case FamilyEVM:
+		return parseEVM(addr)
+	case FamilyTron:
+		return parseTron(addr)
+	default:
+		return parseBTC(addr)
+	}
+}
+
+// Validate checks that an address is well formed for a chain and, when the
+// encoding identifies one, that it belongs to the given network. An empty
+// network skips the network check.
+func Validate(chain, network, addr string) error {
+	a, err := Parse(chain, addr)
+	if err != nil {
+		return err
+	}
+	if network == "" || a.Network == "" {
+		return nil
+	}
+	if !networkMatches(a.Network, network) {
+		return fmt.Errorf("%w: %s address on %s", ErrNetworkMismatch, a.Network, network)
+	}
+	return nil
+}
+
+// Normalize returns the canonical form of an address: EIP-55 checksummed for
+// EVM chains, lowercase for bech32 and base58check for Tron
+func Normalize(chain, addr string) (string, error) {
+	a, err := Parse(chain, addr)
+	if err != nil {
+		return "", err
+	}
+	return a.Normalized, nil
+}
+
+// networkMatches compares a detected network with a configured one. Bitcoin
+// testnet base58 addresses are shared by testnet, signet and regtest.
+func networkMatches(detected, configured string) bool {
+	configured = strings.ToLower(configured)
+	switch detected {
+	case NetworkMainnet:
+		return configured == NetworkMainnet
+	case NetworkRegtest:
+		return configured == NetworkRegtest
+	default:
+		return configured != NetworkMainnet
+	}
+}
+
+// ============ EVM ============
+
+// ToChecksumAddress returns the EIP-55 mixed-case form of an EVM address
+func ToChecksumAddress(addr string) (string, error) {
+	a, err := parseEVM(addr)
+	if err != nil {
+		return "", err
+	}
+	return a.Normalized, nil
+}
+
+func parseEVM(addr string) (*Address, error) {
+	if len(addr) != 42 || (addr[:2] != "0x" && addr[:2] != "0X") {
+		return nil, fmt.Errorf("%w: EVM address must be 0x followed by 40 hex characters", ErrInvalidAddress)
+	}
+
+	body := addr[2:]
+	if _, err := hex.DecodeString(body); err != nil {
+		return nil, fmt.Errorf("%w: EVM address is not hex", ErrInvalidAddress)
+	}
+
+	checksummed := "0x" + eip55(body)
+
+	// All-lowercase and all-uppercase addresses carry no checksum
+	if body != strings.ToLower(body) && body != strings.ToUpper(body) && checksummed[2:] != body {
+		return nil, fmt.Errorf("%w: EIP-55 checksum mismatch", ErrInvalidChecksum)
+	}
+
+	return &Address{
+		Family:     FamilyEVM,
+		Format:     FormatEIP55,
+		Normalized: checksummed,
+	}, nil
+}
+
+// eip55 applies the EIP-55 casing to 40 hex characters
+func eip55(body string) string {
+	lower := strings.ToLower(body)
+	hash := keccak.Sum256([]byte(lower))
+
+	out := []byte(lower)
+	for i := range out {
+		if out[i] < 'a' {
+			continue
+		}
+		nibble := hash[i/2]
+		if i%2 == 0 {
+			nibble >>= 4
+		}
+		if nibble&0x0f >= 8 {
+			out[i] -= 'a' - 'A'
+		}
+	}
+	return string(out)
+}
+
+// ============ Tron ============
+
+const tronVersion = 0x41
+
+func parseTron(addr string) (*Address, error) {
+	// Tron nodes also report addresses as 41-prefixed hex
+	if len(addr) == 42 && strings.HasPrefix(addr, "41") {
+		raw, err := hex.DecodeString(addr)
+		if err == nil {
+			return &Address{
+				Family:     FamilyTron,
+				Format:     FormatBase58Check,
+				Normalized: base58CheckEncode(tronVersion, raw[1:]),
+			}, nil
+		}
+	}
+
+	version, payload, err := base58CheckDecode(addr)
+	if err != nil {
+		return nil, err
+	}
+	if version != tronVersion || len(payload) != 20 {
+		return nil, fmt.Errorf("%w: not a Tron address", ErrInvalidAddress)
+	}
+
+	return &Address{
+		Family:     FamilyTron,
+		Format:     FormatBase58Check,
+		Normalized: addr,
+	}, nil
+}
+
+// TronBytes returns the 20-byte account identifier of a Tron address, as
+// used in contract call arguments
+func TronBytes(addr string) ([]byte, error) {
+	a, err := parseTron(strings.TrimSpace(addr))
+	if err != nil {
+		return nil, err
+	}
+	_, payload, err := base58CheckDecode(a.Normalized)
+	if err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// ============ Bitcoin ============
+
+var btcBase58Networks = map[byte]string{
+	0x00: NetworkMainnet, // P2PKH
+	0x05: NetworkMainnet, // P2SH
+	0x6f: NetworkTestnet, // P2PKH
+	0xc4: NetworkTestnet, // P2SH
+}
+
+var btcBech32Networks = map[string]string{
+	"bc":   NetworkMainnet,
+	"tb":   NetworkTestnet,
+	"bcrt": NetworkRegtest,
+}
+
+func parseBTC(addr string) (*Address, error) {
+	lower := strings.ToLower(addr)
+	for hrp, network := range btcBech32Networks {
+		if !strings.HasPrefix(lower, hrp+"1") {
+			continue
+		}
+
+		decodedHRP, version, _, err := decodeSegwit(addr)
+		if err != nil {
+			return nil, err
+		}
+		if decodedHRP != hrp {
+			continue
+		}
+
+		format := FormatBech32
+		if version > 0 {
+			format = FormatBech32m
+		}
+		return &Address{
+			Family:     FamilyBTC,
+			Format:     format,
+			Network:    network,
+			Normalized: lower,
+		}, nil
+	}
+
+	version, payload, err := base58CheckDecode(addr)
+	if err != nil {
+		return nil, err
+	}
+	network, ok := btcBase58Networks[version]
+	if !ok || len(payload) != 20 {
+		return nil, fmt.Errorf("%w: not a Bitcoin address", ErrInvalidAddress)
+	}
+
+	return &Address{
+		Family:     FamilyBTC,
+		Format:     FormatBase58Check,
+		Network:    network,
+		Normalized: addr,
+	}, nil
+}
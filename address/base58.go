@@ -0,0 +1,98 @@
+package address
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"math/big"
+)
+
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+var base58Index = func() [256]int {
+	var idx [256]int
+	for i := range idx {
+		idx[i] = -1
+	}
+	for i := 0; i < len(base58Alphabet); i++ {
+		idx[base58Alphabet[i]] = i
+	}
+	return idx
+}()
+
+// base58Decode decodes a base58 string using the Bitcoin alphabet
+func base58Decode(s string) ([]byte, error) {
+	if s == "" {
+		return nil, fmt.Errorf("%w: empty base58 string", ErrInvalidAddress)
+	}
+
+	n := new(big.Int)
+	radix := big.NewInt(58)
+	for i := 0; i < len(s); i++ {
+		v := base58Index[s[i]]
+		if v < 0 {
+			return nil, fmt.Errorf("%w: invalid base58 character %q", ErrInvalidAddress, s[i])
+		}
+		n.Mul(n, radix)
+		n.Add(n, big.NewInt(int64(v)))
+	}
+
+	// Leading '1' characters encode leading zero bytes
+	zeros := 0
+	for zeros < len(s) && s[zeros] == '1' {
+		zeros++
+	}
+
+	return append(make([]byte, zeros), n.Bytes()...), nil
+}
+
+// base58Encode encodes bytes using the Bitcoin alphabet
+func base58Encode(b []byte) string {
+	n := new(big.Int).SetBytes(b)
+	radix := big.NewInt(58)
+	mod := new(big.Int)
+
+	var out []byte
+	for n.Sign() > 0 {
+		n.DivMod(n, radix, mod)
+		out = append(out, base58Alphabet[mod.Int64()])
+	}
+	for i := 0; i < len(b) && b[i] == 0; i++ {
+		out = append(out, '1')
+	}
+
+	for i, j := 0, len(out)-1; i < j; i, j = i+1, j-1 {
+		out[i], out[j] = out[j], out[i]
+	}
+	return string(out)
+}
+
+// base58CheckDecode decodes a base58check string and returns the version byte and payload
+func base58CheckDecode(s string) (byte, []byte, error) {
+	raw, err := base58Decode(s)
+	if err != nil {
+		return 0, nil, err
+	}
+	if len(raw) < 5 {
+		return 0, nil, fmt.Errorf("%w: base58check payload too short", ErrInvalidAddress)
+	}
+
+	body, sum := raw[:len(raw)-4], raw[len(raw)-4:]
+	if !bytes.Equal(checksum(body), sum) {
+		return 0, nil, fmt.Errorf("%w: base58check checksum mismatch", ErrInvalidChecksum)
+	}
+	return body[0], body[1:], nil
+}
+
+// base58CheckEncode encodes a version byte and payload as base58check
+func base58CheckEncode(version byte, payload []byte) string {
+	body := append([]byte{version}, payload...)
+	return base58Encode(append(body, checksum(body)...))
+}
+
+// checksum returns the first four bytes of a double SHA-256
+func checksum(b []byte) []byte {
+	first := sha256.Sum256(b)
+	second := sha256.Sum256(first[:])
+	return second[:4]
+}
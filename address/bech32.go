@@ -0,0 +1,148 @@
+package address
+
+import (
+	"fmt"
+	"strings"
+)
+
+const bech32Charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+const (
+	bech32Const  = 1
+	bech32mConst = 0x2bc830a3
+)
+
+// bech32Polymod computes the BCH checksum defined in BIP-173
+func bech32Polymod(values []byte) uint32 {
+	gen := [5]uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+	chk := uint32(1)
+	for _, v := range values {
+		top := chk >> 25
+		chk = (chk&0x1ffffff)<<5 ^ uint32(v)
+		for i := 0; i < 5; i++ {
+			if (top>>uint(i))&1 == 1 {
+				chk ^= gen[i]
+			}
+		}
+	}
+	return chk
+}
+
+// bech32HRPExpand expands the human-readable part for checksum computation
+func bech32HRPExpand(hrp string) []byte {
+	out := make([]byte, 0, len(hrp)*2+1)
+	for i := 0; i < len(hrp); i++ {
+		out = append(out, hrp[i]>>5)
+	}
+	out = append(out, 0)
+	for i := 0; i < len(hrp); i++ {
+		out = append(out, hrp[i]&31)
+	}
+	return out
+}
+
+// bech32Decode decodes a bech32 or bech32m string and returns the
+// human-readable part, the 5-bit data (without checksum) and the
+// checksum constant that matched
+func bech32Decode(s string) (string, []byte, uint32, error) {
+	if len(s) > 90 {
+		return "", nil, 0, fmt.Errorf("%w: bech32 string too long", ErrInvalidAddress)
+	}
+	if strings.ToLower(s) != s && strings.ToUpper(s) != s {
+		return "", nil, 0, fmt.Errorf("%w: bech32 string has mixed case", ErrInvalidAddress)
+	}
+	s = strings.ToLower(s)
+
+	pos := strings.LastIndexByte(s, '1')
+	if pos < 1 || pos+7 > len(s) {
+		return "", nil, 0, fmt.Errorf("%w: invalid bech32 separator position", ErrInvalidAddress)
+	}
+
+	hrp := s[:pos]
+	for i := 0; i < len(hrp); i++ {
+		if hrp[i] < 33 || hrp[i] > 126 {
+			return "", nil, 0, fmt.Errorf("%w: invalid bech32 prefix", ErrInvalidAddress)
+		}
+	}
+
+	data := make([]byte, 0, len(s)-pos-1)
+	for i := pos + 1; i < len(s); i++ {
+		v := strings.IndexByte(bech32Charset, s[i])
+		if v < 0 {
+			return "", nil, 0, fmt.Errorf("%w: invalid bech32 character %q", ErrInvalidAddress, s[i])
+		}
+		data = append(data, byte(v))
+	}
+
+	c := bech32Polymod(append(bech32HRPExpand(hrp), data...))
+	if c != bech32Const && c != bech32mConst {
+		return "", nil, 0, fmt.Errorf("%w: bech32 checksum mismatch", ErrInvalidChecksum)
+	}
+
+	return hrp, data[:len(data)-6], c, nil
+}
+
+// convertBits regroups a byte slice from one bit width to another
+func convertBits(data []byte, from, to uint, pad bool) ([]byte, error) {
+	acc, bits := uint32(0), uint(0)
+	maxv := uint32(1)<<to - 1
+
+	var out []byte
+	for _, v := range data {
+		if uint32(v)>>from != 0 {
+			return nil, fmt.Errorf("%w: invalid data range", ErrInvalidAddress)
+		}
+		acc = acc<<from | uint32(v)
+		bits += from
+		for bits >= to {
+			bits -= to
+			out = append(out, byte(acc>>bits&maxv))
+		}
+	}
+
+	if pad {
+		if bits > 0 {
+			out = append(out, byte(acc<<(to-bits)&maxv))
+		}
+	} else if bits >= from || acc<<(to-bits)&maxv != 0 {
+		return nil, fmt.Errorf("%w: invalid padding", ErrInvalidAddress)
+	}
+	return out, nil
+}
+
+// decodeSegwit decodes a segregated witness address per BIP-173 and BIP-350
+func decodeSegwit(s string) (hrp string, version byte, program []byte, err error) {
+	hrp, data, constant, err := bech32Decode(s)
+	if err != nil {
+		return "", 0, nil, err
+	}
+	if len(data) < 1 {
+		return "", 0, nil, fmt.Errorf("%w: empty witness data", ErrInvalidAddress)
+	}
+
+	version = data[0]
+	if version > 16 {
+		return "", 0, nil, fmt.Errorf("%w: invalid witness version %d", ErrInvalidAddress, version)
+	}
+
+	program, err = convertBits(data[1:], 5, 8, false)
+	if err != nil {
+		return "", 0, nil, err
+	}
+	if len(program) < 2 || len(program) > 40 {
+		return "", 0, nil, fmt.Errorf("%w: invalid witness program length %d", ErrInvalidAddress, len(program))
+	}
+	if version == 0 && len(program) != 20 && len(program) != 32 {
+		return "", 0, nil, fmt.Errorf("%w: invalid v0 witness program length %d", ErrInvalidAddress, len(program))
+	}
+
+	// Version 0 uses bech32, later versions use bech32m
+	if version == 0 && constant != bech32Const {
+		return "", 0, nil, fmt.Errorf("%w: witness v0 must use bech32", ErrInvalidChecksum)
+	}
+	if version != 0 && constant != bech32mConst {
+		return "", 0, nil, fmt.Errorf("%w: witness v%d must use bech32m", ErrInvalidChecksum, version)
+	}
+
+	return hrp, version, program, nil
+}
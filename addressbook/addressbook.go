@@ -0,0 +1,217 @@
+// Package addressbook manages a wallet's saved withdrawal addresses. New
+// entries only become usable after a cooldown, so a compromised session
+// cannot add an address and withdraw to it immediately.
+package addressbook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/paratro/paratro-sdk-go/address"
+	"github.com/paratro/paratro-sdk-go/common"
+	"github.com/paratro/paratro-sdk-go/transaction"
+)
+
+var (
+	// ErrNotAllowlisted is returned when a destination has no address book entry
+	ErrNotAllowlisted = errors.New("destination is not in the address book")
+	// ErrEntryNotActive is returned when a destination's entry is still cooling down
+	ErrEntryNotActive = errors.New("address book entry is not active yet")
+)
+
+// Service handles address book API operations
+type Service struct {
+	client       *common.Client
+	transactions *transaction.Service
+}
+
+// NewService creates a new address book service that submits allowlisted
+// transfers through transactions
+func NewService(client *common.Client, transactions *transaction.Service) *Service {
+	return &Service{client: client, transactions: transactions}
+}
+
+// Entry is a saved withdrawal address
+type Entry struct {
+	EntryID     string         `json:"entry_id"`
+	WalletID    string         `json:"wallet_id"`
+	Chain       common.Chain   `json:"chain"`
+	Network     common.Network `json:"network"`
+	Address     string         `json:"address"`
+	Label       string         `json:"label"`
+	Memo        string         `json:"memo,omitempty"` // Memo or destination tag the recipient requires
+	ActivatesAt common.Time    `json:"activates_at"`   // Entry is usable from this time
+	CreatedBy   string         `json:"created_by,omitempty"`
+	CreatedAt   common.Time    `json:"created_at"`
+}
+
+// IsActive reports whether the entry's cooldown has elapsed at t
+func (e *Entry) IsActive(t time.Time) bool {
+	return !e.ActivatesAt.IsZero() && !t.Before(e.ActivatesAt.Time)
+}
+
+// CreateEntryRequest represents a request to save a withdrawal address
+type CreateEntryRequest struct {
+	Chain   common.Chain   `json:"chain"`
+	Network common.Network `json:"network"`
+	Address string         `json:"address"`
+	Label   string         `json:"label"`
+	Memo    string         `json:"memo,omitempty"`
+
+	// ActivatesAt delays activation beyond the wallet's cooldown; it cannot
+	// shorten it. Zero uses the cooldown.
+	ActivatesAt time.Time `json:"-"`
+}
+
+// Create saves a withdrawal address after validating it locally
+func (s *Service) Create(ctx context.Context, walletID string, req *CreateEntryRequest) (*Entry, error) {
+	if err := address.Validate(string(req.Chain), string(req.Network), req.Address); err != nil {
+		return nil, fmt.Errorf("invalid address: %w", err)
+	}
+
+	body := struct {
+		*CreateEntryRequest
+		ActivatesAt *common.Time `json:"activates_at,omitempty"`
+	}{CreateEntryRequest: req}
+	if !req.ActivatesAt.IsZero() {
+		activatesAt := common.NewTime(req.ActivatesAt.UTC())
+		body.ActivatesAt = &activatesAt
+	}
+
+	var entry Entry
+	path := fmt.Sprintf("/api/v1/wallets/%s/address-book", walletID)
+	err := s.client.Request("POST", path, &body, &entry)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create address book entry: %w", err)
+	}
+	return &entry, nil
+}
+
+// ListEntriesRequest represents a request to list address book entries
+type ListEntriesRequest struct {
+	Chain    common.Chain   `json:"chain,omitempty"`
+	Network  common.Network `json:"network,omitempty"`
+	Address  string         `json:"address,omitempty"`
+	Page     int            `json:"page,omitempty"`
+	PageSize int            `json:"page_size,omitempty"`
+}
+
+// ListEntriesResponse represents a paginated list of address book entries
+type ListEntriesResponse struct {
+	Items      []*Entry `json:"items"`
+	Page       int      `json:"page"`
+	PageSize   int      `json:"page_size"`
+	TotalCount int      `json:"total_count"`
+	TotalPages int      `json:"total_pages"`
+}
+
+// List retrieves a wallet's address book entries
+func (s *Service) List(ctx context.Context, walletID string, req *ListEntriesRequest) (*ListEntriesResponse, error) {
+	params := make(map[string]string)
+
+	if req != nil {
+		if req.Chain != "" {
+			params["chain"] = string(req.Chain)
+		}
+		if req.Network != "" {
+			params["network"] = string(req.Network)
+		}
+		if req.Address != "" {
+			params["address"] = req.Address
+		}
+		if req.Page > 0 {
+			params["page"] = strconv.Itoa(req.Page)
+		}
+		if req.PageSize > 0 {
+			params["page_size"] = strconv.Itoa(req.PageSize)
+		}
+	}
+
+	var entries []*Entry
+	path := fmt.Sprintf("/api/v1/wallets/%s/address-book", walletID)
+	err := s.client.RequestWithQuery(path, params, &entries)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list address book entries: %w", err)
+	}
+	return &ListEntriesResponse{
+		Items: entries,
+	}, nil
+}
+
+// Delete removes an address book entry
+func (s *Service) Delete(ctx context.Context, walletID, entryID string) error {
+	path := fmt.Sprintf("/api/v1/wallets/%s/address-book/%s", walletID, entryID)
+	err := s.client.Request("DELETE", path, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete address book entry: %w", err)
+	}
+	return nil
+}
+
+// CheckDestination returns the active entry for a destination, or
+// ErrNotAllowlisted / ErrEntryNotActive. Addresses are compared in
+// normalized form, so checksum or case differences do not matter.
+func (s *Service) CheckDestination(ctx context.Context, walletID string, chain common.Chain, network common.Network, addr string) (*Entry, error) {
+	normalized, err := address.Normalize(string(chain), addr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid address: %w", err)
+	}
+
+	resp, err := s.List(ctx, walletID, &ListEntriesRequest{Chain: chain, Network: network, Address: normalized})
+	if err != nil {
+		return nil, err
+	}
+
+	var pending *Entry
+	now := time.Now()
+	for _, e := range resp.Items {
+		if n, err := address.Normalize(string(chain), e.Address); err != nil || n != normalized {
+			continue
+		}
+		if e.IsActive(now) {
+			return e, nil
+		}
+		pending = e
+	}
+	if pending != nil {
+		return nil, fmt.Errorf("%w: %s activates at %s", ErrEntryNotActive, addr, pending.ActivatesAt.Format(time.RFC3339))
+	}
+	return nil, fmt.Errorf("%w: %s", ErrNotAllowlisted, addr)
+}
+
+// Transfer submits a transfer only if its destination is an active address
+// book entry. The check is repeated server-side via RequireAllowlisted. When
+// the entry has a memo, the transfer requires one and uses the entry's memo
+// or destination tag if the request has none.
+func (s *Service) Transfer(ctx context.Context, walletID string, req *transaction.CreateTransferRequest) (*transaction.Transaction, error) {
+	if req.Chain == "" {
+		return nil, fmt.Errorf("chain is required for an allowlisted transfer")
+	}
+
+	entry, err := s.CheckDestination(ctx, walletID, req.Chain, req.Network, req.ToAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	body := *req
+	body.RequireAllowlisted = true
+	if entry.Memo != "" {
+		body.RequireTag = true
+		if body.Memo == "" && !body.Extras.HasTag() {
+			extras, err := transaction.MemoExtras(req.Chain, entry.Memo)
+			if err != nil {
+				return nil, err
+			}
+			if body.Extras != nil {
+				merged := *body.Extras
+				merged.Memo, merged.DestinationTag = extras.Memo, extras.DestinationTag
+				extras = &merged
+			}
+			body.Extras = extras
+		}
+	}
+	return s.transactions.Transfer(ctx, &body)
+}
@@ -0,0 +1,290 @@
+// Package amount provides an exact decimal type for balances, transfer
+// amounts and fees. Values are stored as an integer number of base units
+// (wei, sun, satoshi, ...) together with the number of decimals.
+package amount
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// MaxDecimals is the largest number of decimals an Amount may carry
+const MaxDecimals = 77
+
+var (
+	// ErrInvalidAmount is returned for strings that are not decimal numbers
+	ErrInvalidAmount = errors.New("invalid amount")
+	// ErrPrecisionLoss is returned when a value has more decimals than allowed
+	ErrPrecisionLoss = errors.New("amount exceeds precision")
+)
+
+// Amount is an arbitrary-precision decimal. The zero value is 0.
+type Amount struct {
+	base     *big.Int
+	decimals int
+}
+
+// Zero returns 0 with the given number of decimals
+func Zero(decimals int) Amount {
+	return Amount{base: new(big.Int), decimals: decimals}
+}
+
+// FromBaseUnits returns an amount of base units with the given number of
+// decimals. A nil base is treated as zero.
+func FromBaseUnits(base *big.Int, decimals int) Amount {
+	if base == nil {
+		return Zero(decimals)
+	}
+	return Amount{base: new(big.Int).Set(base), decimals: decimals}
+}
+
+// FromInt64 returns an amount of base units with the given number of decimals
+func FromInt64(base int64, decimals int) Amount {
+	return Amount{base: big.NewInt(base), decimals: decimals}
+}
+
+// Parse parses a display-unit decimal string such as "-12.345". The number
+// of decimals is taken from the string.
+func Parse(s string) (Amount, error) {
+	s = strings.TrimSpace(s)
+
+	neg := false
+	if strings.HasPrefix(s, "-") || strings.HasPrefix(s, "+") {
+		neg = s[0] == '-'
+		s = s[1:]
+	}
+
+	whole, frac := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		whole, frac = s[:i], s[i+1:]
+	}
+	if (whole == "" && frac == "") || !isDigits(whole) || !isDigits(frac) {
+		return Amount{}, fmt.Errorf("%w: %q", ErrInvalidAmount, s)
+	}
+	if len(frac) > MaxDecimals {
+		return Amount{}, fmt.Errorf("%w: %q has more than %d decimals", ErrPrecisionLoss, s, MaxDecimals)
+	}
+
+	base, ok := new(big.Int).SetString(whole+frac, 10)
+	if !ok {
+		return Amount{}, fmt.Errorf("%w: %q", ErrInvalidAmount, s)
+	}
+	if neg {
+		base.Neg(base)
+	}
+	return Amount{base: base, decimals: len(frac)}, nil
+}
+
+// ParseWithDecimals parses a display-unit decimal string into an amount with
+// exactly the given number of decimals. It fails if the string is more precise.
+func ParseWithDecimals(s string, decimals int) (Amount, error) {
+	a, err := Parse(s)
+	if err != nil {
+		return Amount{}, err
+	}
+	return a.Rescale(decimals)
+}
+
+// ParseBaseUnits parses an integer string of base units
+func ParseBaseUnits(s string, decimals int) (Amount, error) {
+	base, ok := new(big.Int).SetString(strings.TrimSpace(s), 10)
+	if !ok {
+		return Amount{}, fmt.Errorf("%w: %q is not an integer", ErrInvalidAmount, s)
+	}
+	return Amount{base: base, decimals: decimals}, nil
+}
+
+// MustParse is like Parse but panics on error
+func MustParse(s string) Amount {
+	a, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// BaseUnits returns a copy of the value in base units
+func (a Amount) BaseUnits() *big.Int {
+	return new(big.Int).Set(a.int())
+}
+
+// Decimals returns the number of decimals
+func (a Amount) Decimals() int {
+	return a.decimals
+}
+
+// Sign returns -1, 0 or +1
+func (a Amount) Sign() int {
+	return a.int().Sign()
+}
+
+// IsZero reports whether the amount is zero
+func (a Amount) IsZero() bool {
+	return a.Sign() == 0
+}
+
+// Cmp compares two amounts regardless of their decimals
+func (a Amount) Cmp(b Amount) int {
+	x, y := align(a, b)
+	return x.Cmp(y)
+}
+
+// Equal reports whether two amounts have the same value
+func (a Amount) Equal(b Amount) bool {
+	return a.Cmp(b) == 0
+}
+
+// Add returns a + b using the larger number of decimals
+func (a Amount) Add(b Amount) Amount {
+	x, y := align(a, b)
+	return Amount{base: x.Add(x, y), decimals: maxInt(a.decimals, b.decimals)}
+}
+
+// Sub returns a - b using the larger number of decimals
+func (a Amount) Sub(b Amount) Amount {
+	x, y := align(a, b)
+	return Amount{base: x.Sub(x, y), decimals: maxInt(a.decimals, b.decimals)}
+}
+
+// MulInt64 returns a * n
+func (a Amount) MulInt64(n int64) Amount {
+	return Amount{base: new(big.Int).Mul(a.int(), big.NewInt(n)), decimals: a.decimals}
+}
+
+// Neg returns -a
+func (a Amount) Neg() Amount {
+	return Amount{base: new(big.Int).Neg(a.int()), decimals: a.decimals}
+}
+
+// Abs returns |a|
+func (a Amount) Abs() Amount {
+	return Amount{base: new(big.Int).Abs(a.int()), decimals: a.decimals}
+}
+
+// Rescale returns the same value with the given number of decimals. It fails
+// if reducing the decimals would drop non-zero digits.
+func (a Amount) Rescale(decimals int) (Amount, error) {
+	if decimals < 0 || decimals > MaxDecimals {
+		return Amount{}, fmt.Errorf("%w: %d decimals out of range", ErrInvalidAmount, decimals)
+	}
+	if decimals >= a.decimals {
+		return Amount{base: scaleUp(a.int(), decimals-a.decimals), decimals: decimals}, nil
+	}
+
+	q, r := new(big.Int).QuoRem(a.int(), pow10(a.decimals-decimals), new(big.Int))
+	if r.Sign() != 0 {
+		return Amount{}, fmt.Errorf("%w: %s has more than %d decimals", ErrPrecisionLoss, a, decimals)
+	}
+	return Amount{base: q, decimals: decimals}, nil
+}
+
+// Truncate drops digits beyond the given number of decimals, rounding toward zero
+func (a Amount) Truncate(decimals int) Amount {
+	if decimals < 0 {
+		decimals = 0
+	}
+	if decimals >= a.decimals {
+		return Amount{base: scaleUp(a.int(), decimals-a.decimals), decimals: decimals}
+	}
+	return Amount{base: new(big.Int).Quo(a.int(), pow10(a.decimals-decimals)), decimals: decimals}
+}
+
+// String formats the amount in display units without trailing zeros
+func (a Amount) String() string {
+	s := a.StringFixed()
+	if strings.IndexByte(s, '.') >= 0 {
+		s = strings.TrimRight(s, "0")
+		s = strings.TrimSuffix(s, ".")
+	}
+	return s
+}
+
+// StringFixed formats the amount in display units with all decimals
+func (a Amount) StringFixed() string {
+	digits := new(big.Int).Abs(a.int()).String()
+	if a.decimals > 0 {
+		if len(digits) <= a.decimals {
+			digits = strings.Repeat("0", a.decimals-len(digits)+1) + digits
+		}
+		cut := len(digits) - a.decimals
+		digits = digits[:cut] + "." + digits[cut:]
+	}
+	if a.Sign() < 0 {
+		return "-" + digits
+	}
+	return digits
+}
+
+// MarshalJSON encodes the amount as a quoted display-unit string
+func (a Amount) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.String())
+}
+
+// UnmarshalJSON accepts a quoted or bare decimal number. Empty strings and
+// null decode to zero.
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		*a = Amount{}
+		return nil
+	}
+
+	s := string(data)
+	if len(data) > 0 && data[0] == '"' {
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+	}
+	if strings.TrimSpace(s) == "" {
+		*a = Amount{}
+		return nil
+	}
+
+	parsed, err := Parse(s)
+	if err != nil {
+		return err
+	}
+	*a = parsed
+	return nil
+}
+
+func (a Amount) int() *big.Int {
+	if a.base == nil {
+		return new(big.Int)
+	}
+	return a.base
+}
+
+// align returns copies of both values scaled to the same number of decimals
+func align(a, b Amount) (*big.Int, *big.Int) {
+	d := maxInt(a.decimals, b.decimals)
+	return scaleUp(a.int(), d-a.decimals), scaleUp(b.int(), d-b.decimals)
+}
+
+func scaleUp(v *big.Int, places int) *big.Int {
+	return new(big.Int).Mul(v, pow10(places))
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,206 @@
+// Package approval manages transactions held in AWAITING_APPROVAL by a
+// wallet's multi-approver policy: listing them, voting and auditing votes.
+package approval
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/paratro/paratro-sdk-go/common"
+	"github.com/paratro/paratro-sdk-go/transaction"
+)
+
+// Service handles approval-related API operations
+type Service struct {
+	client *common.Client
+}
+
+// NewService creates a new approval service
+func NewService(client *common.Client) *Service {
+	return &Service{client: client}
+}
+
+// Status is the state of an approval
+type Status string
+
+// Approval statuses
+const (
+	StatusPending  Status = "PENDING"
+	StatusApproved Status = "APPROVED"
+	StatusRejected Status = "REJECTED"
+	StatusExpired  Status = "EXPIRED"
+)
+
+var statuses = []string{string(StatusPending), string(StatusApproved), string(StatusRejected), string(StatusExpired)}
+
+// ParseStatus returns the approval status for s, ignoring case
+func ParseStatus(s string) (Status, error) {
+	v, err := common.ParseEnum("approval status", s, statuses...)
+	return Status(v), err
+}
+
+// IsKnown reports whether s is one of the Status constants
+func (s Status) IsKnown() bool {
+	v, err := ParseStatus(string(s))
+	return err == nil && v == s
+}
+
+// UnmarshalJSON accepts any case and keeps unknown statuses as sent
+func (s *Status) UnmarshalJSON(data []byte) error {
+	v, err := common.UnmarshalEnum(data, statuses...)
+	*s = Status(v)
+	return err
+}
+
+// Decision actions
+const (
+	ActionApprove = "APPROVE"
+	ActionReject  = "REJECT"
+)
+
+// Decision is one approver's vote on a transaction
+type Decision struct {
+	ApproverID   string      `json:"approver_id"`
+	ApproverName string      `json:"approver_name,omitempty"`
+	Action       string      `json:"action"` // APPROVE, REJECT
+	Comment      string      `json:"comment,omitempty"`
+	CreatedAt    common.Time `json:"created_at"`
+}
+
+// Approval represents the approval state of a transaction held by a
+// multi-approver policy
+type Approval struct {
+	TxID              string                   `json:"tx_id"`
+	WalletID          string                   `json:"wallet_id"`
+	AccountID         string                   `json:"account_id"`
+	PolicyID          string                   `json:"policy_id,omitempty"`
+	Status            Status                   `json:"status"`
+	RequiredApprovals int                      `json:"required_approvals"`
+	Approvals         int                      `json:"approvals"` // Approvals received so far
+	Decisions         []*Decision              `json:"decisions,omitempty"`
+	Transaction       *transaction.Transaction `json:"transaction,omitempty"`
+	ExpiresAt         common.Time              `json:"expires_at"`
+	CreatedAt         common.Time              `json:"created_at"`
+	UpdatedAt         common.Time              `json:"updated_at"`
+}
+
+// Remaining returns the number of approvals still needed
+func (a *Approval) Remaining() int {
+	if a.Approvals >= a.RequiredApprovals {
+		return 0
+	}
+	return a.RequiredApprovals - a.Approvals
+}
+
+// HasDecided reports whether an approver has already voted
+func (a *Approval) HasDecided(approverID string) bool {
+	for _, d := range a.Decisions {
+		if d.ApproverID == approverID {
+			return true
+		}
+	}
+	return false
+}
+
+// ListPendingRequest represents a request to list transactions awaiting approval
+type ListPendingRequest struct {
+	WalletID  string `json:"wallet_id,omitempty"`
+	AccountID string `json:"account_id,omitempty"`
+	Page      int    `json:"page,omitempty"`
+	PageSize  int    `json:"page_size,omitempty"`
+}
+
+// ListApprovalsResponse represents a paginated list of approvals
+type ListApprovalsResponse struct {
+	Items      []*Approval `json:"items"`
+	Page       int         `json:"page"`
+	PageSize   int         `json:"page_size"`
+	TotalCount int         `json:"total_count"`
+	TotalPages int         `json:"total_pages"`
+}
+
+// ListPending retrieves transactions in AWAITING_APPROVAL status
+func (s *Service) ListPending(ctx context.Context, req *ListPendingRequest) (*ListApprovalsResponse, error) {
+	params := map[string]string{
+		"status": string(StatusPending),
+	}
+
+	if req != nil {
+		if req.WalletID != "" {
+			params["wallet_id"] = req.WalletID
+		}
+		if req.AccountID != "" {
+			params["account_id"] = req.AccountID
+		}
+		if req.Page > 0 {
+			params["page"] = strconv.Itoa(req.Page)
+		}
+		if req.PageSize > 0 {
+			params["page_size"] = strconv.Itoa(req.PageSize)
+		}
+	}
+
+	var approvals []*Approval
+	err := s.client.RequestWithQuery("/api/v1/approvals", params, &approvals)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pending approvals: %w", err)
+	}
+	return &ListApprovalsResponse{
+		Items: approvals,
+	}, nil
+}
+
+// Get retrieves the approval state of a transaction
+func (s *Service) Get(ctx context.Context, txID string) (*Approval, error) {
+	var approval Approval
+	path := fmt.Sprintf("/api/v1/approvals/%s", txID)
+	err := s.client.Request("GET", path, nil, &approval)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get approval: %w", err)
+	}
+	return &approval, nil
+}
+
+type decisionRequest struct {
+	Comment string `json:"comment,omitempty"`
+}
+
+// Approve records the caller's approval of a transaction. Once the policy's
+// required approvals are reached the transaction is signed and broadcast.
+func (s *Service) Approve(ctx context.Context, txID, comment string) (*Approval, error) {
+	var approval Approval
+	path := fmt.Sprintf("/api/v1/approvals/%s/approve", txID)
+	err := s.client.Request("POST", path, &decisionRequest{Comment: comment}, &approval)
+	if err != nil {
+		return nil, fmt.Errorf("failed to approve transaction: %w", err)
+	}
+	return &approval, nil
+}
+
+// Reject rejects a transaction. A single rejection is final; the
+// transaction moves to REJECTED and is never signed.
+func (s *Service) Reject(ctx context.Context, txID, comment string) (*Approval, error) {
+	if comment == "" {
+		return nil, fmt.Errorf("comment is required to reject a transaction")
+	}
+
+	var approval Approval
+	path := fmt.Sprintf("/api/v1/approvals/%s/reject", txID)
+	err := s.client.Request("POST", path, &decisionRequest{Comment: comment}, &approval)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reject transaction: %w", err)
+	}
+	return &approval, nil
+}
+
+// Trail retrieves every decision recorded for a transaction, oldest first
+func (s *Service) Trail(ctx context.Context, txID string) ([]*Decision, error) {
+	var decisions []*Decision
+	path := fmt.Sprintf("/api/v1/approvals/%s/trail", txID)
+	err := s.client.Request("GET", path, nil, &decisions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get approval trail: %w", err)
+	}
+	return decisions, nil
+}
@@ -3,8 +3,10 @@ package asset
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"strconv"
 
+	"github.com/paratro/paratro-sdk-go/amount"
 	"github.com/paratro/paratro-sdk-go/common"
 )
 
@@ -20,24 +22,75 @@ func NewService(client *common.Client) *Service {
 
 // CreateAssetRequest represents a request to add a new asset
 type CreateAssetRequest struct {
-	AccountID string `json:"account_id"`
-	Symbol    string `json:"symbol"`
+	AccountID       string `json:"account_id"`
+	Symbol          string `json:"symbol"`
+	ContractAddress string `json:"contract_address,omitempty"` // Disambiguates tokens sharing a symbol
 }
 
 // Asset represents an asset (token) in an account
 type Asset struct {
-	AssetID         string `json:"asset_id"`
-	WalletID        string `json:"wallet_id"`
-	AccountID       string `json:"account_id"`
-	Symbol          string `json:"symbol"`
-	Name            string `json:"name"`
-	AssetType       string `json:"asset_type"`
-	ContractAddress string `json:"contract_address"`
-	Decimals        int    `json:"decimals"`
-	Balance         string `json:"balance"`
-	Status          string `json:"status"` // ACTIVE, INACTIVE
-	CreatedAt       string `json:"created_at"`
-	UpdatedAt       string `json:"updated_at,omitempty"`
+	AssetID         string        `json:"asset_id"`
+	WalletID        string        `json:"wallet_id"`
+	AccountID       string        `json:"account_id"`
+	Symbol          string        `json:"symbol"`
+	Name            string        `json:"name"`
+	AssetType       string        `json:"asset_type"`
+	ContractAddress string        `json:"contract_address"`
+	Decimals        int           `json:"decimals"`
+	Balance         amount.Amount `json:"balance"`
+	Status          Status        `json:"status"`
+	LastSyncedAt    common.Time   `json:"last_synced_at"` // Last on-chain balance sync
+	CreatedAt       common.Time   `json:"created_at"`
+	UpdatedAt       common.Time   `json:"updated_at"`
+}
+
+// Status is the state of an asset
+type Status string
+
+// Asset statuses
+const (
+	StatusActive   Status = "ACTIVE"
+	StatusInactive Status = "INACTIVE"
+)
+
+var statuses = []string{string(StatusActive), string(StatusInactive)}
+
+// ParseStatus returns the asset status for s, ignoring case
+func ParseStatus(s string) (Status, error) {
+	v, err := common.ParseEnum("asset status", s, statuses...)
+	return Status(v), err
+}
+
+// IsKnown reports whether s is one of the Status constants
+func (s Status) IsKnown() bool {
+	v, err := ParseStatus(string(s))
+	return err == nil && v == s
+}
+
+// UnmarshalJSON accepts any case and keeps unknown statuses as sent
+func (s *Status) UnmarshalJSON(data []byte) error {
+	v, err := common.UnmarshalEnum(data, statuses...)
+	*s = Status(v)
+	return err
+}
+
+// ParseAmount parses a display-unit string using the asset's decimals
+func (a *Asset) ParseAmount(s string) (amount.Amount, error) {
+	return amount.ParseWithDecimals(s, a.Decimals)
+}
+
+// FromBaseUnits converts base units (wei, sun, ...) to an amount of this asset
+func (a *Asset) FromBaseUnits(base *big.Int) amount.Amount {
+	return amount.FromBaseUnits(base, a.Decimals)
+}
+
+// BalanceBaseUnits returns the balance in base units using the asset's decimals
+func (a *Asset) BalanceBaseUnits() (*big.Int, error) {
+	balance, err := a.Balance.Rescale(a.Decimals)
+	if err != nil {
+		return nil, err
+	}
+	return balance.BaseUnits(), nil
 }
 
 // Create creates a new asset for an account
@@ -61,6 +114,49 @@ func (s *Service) Get(ctx context.Context, assetID string) (*Asset, error) {
 	return &asset, nil
 }
 
+// Deactivate marks an asset INACTIVE so it is no longer tracked or usable for transfers
+func (s *Service) Deactivate(ctx context.Context, assetID string) (*Asset, error) {
+	var asset Asset
+	path := fmt.Sprintf("/api/v1/assets/%s/deactivate", assetID)
+	err := s.client.Request("POST", path, nil, &asset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to deactivate asset: %w", err)
+	}
+	return &asset, nil
+}
+
+// Reactivate marks an inactive asset ACTIVE again
+func (s *Service) Reactivate(ctx context.Context, assetID string) (*Asset, error) {
+	var asset Asset
+	path := fmt.Sprintf("/api/v1/assets/%s/reactivate", assetID)
+	err := s.client.Request("POST", path, nil, &asset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reactivate asset: %w", err)
+	}
+	return &asset, nil
+}
+
+// Delete removes an asset from its account
+func (s *Service) Delete(ctx context.Context, assetID string) error {
+	path := fmt.Sprintf("/api/v1/assets/%s", assetID)
+	err := s.client.Request("DELETE", path, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete asset: %w", err)
+	}
+	return nil
+}
+
+// RefreshBalance forces an on-chain balance resync and returns the updated asset
+func (s *Service) RefreshBalance(ctx context.Context, assetID string) (*Asset, error) {
+	var asset Asset
+	path := fmt.Sprintf("/api/v1/assets/%s/refresh", assetID)
+	err := s.client.Request("POST", path, nil, &asset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to refresh asset balance: %w", err)
+	}
+	return &asset, nil
+}
+
 // ListAssetsRequest represents a request to list assets
 type ListAssetsRequest struct {
 	WalletID  string `json:"wallet_id,omitempty"`  // Filter by wallet ID
@@ -106,3 +202,20 @@ func (s *Service) List(ctx context.Context, req *ListAssetsRequest) (*ListAssets
 		Items: assets,
 	}, nil
 }
+
+// ListAll retrieves every page of assets matching req, ignoring its Page
+// and PageSize
+func (s *Service) ListAll(ctx context.Context, req *ListAssetsRequest) ([]*Asset, error) {
+	filter := ListAssetsRequest{}
+	if req != nil {
+		filter = *req
+	}
+	return common.ListAll(common.DefaultPageSize, func(page, pageSize int) ([]*Asset, error) {
+		filter.Page, filter.PageSize = page, pageSize
+		resp, err := s.List(ctx, &filter)
+		if err != nil {
+			return nil, err
+		}
+		return resp.Items, nil
+	})
+}
@@ -0,0 +1,95 @@
+package asset
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/paratro/paratro-sdk-go/amount"
+	"github.com/paratro/paratro-sdk-go/common"
+)
+
+// BalanceSnapshot is the balance of an asset at a point in time
+type BalanceSnapshot struct {
+	AssetID     string        `json:"asset_id"`
+	AccountID   string        `json:"account_id"`
+	WalletID    string        `json:"wallet_id"`
+	Symbol      string        `json:"symbol"`
+	Balance     amount.Amount `json:"balance"`
+	BlockHeight int64         `json:"block_height,omitempty"`
+	Timestamp   common.Time   `json:"timestamp"`
+}
+
+// BalanceQuery selects a point in time by timestamp or block height.
+// If both are set, BlockHeight takes precedence.
+type BalanceQuery struct {
+	Timestamp   time.Time
+	BlockHeight int64
+}
+
+func (q *BalanceQuery) params() map[string]string {
+	params := make(map[string]string)
+	if q == nil {
+		return params
+	}
+	if q.BlockHeight > 0 {
+		params["block_height"] = strconv.FormatInt(q.BlockHeight, 10)
+	} else if !q.Timestamp.IsZero() {
+		params["timestamp"] = q.Timestamp.UTC().Format(time.RFC3339)
+	}
+	return params
+}
+
+// GetBalanceAt retrieves the balance of an asset at a timestamp or block height
+func (s *Service) GetBalanceAt(ctx context.Context, assetID string, query *BalanceQuery) (*BalanceSnapshot, error) {
+	var snapshot BalanceSnapshot
+	path := fmt.Sprintf("/api/v1/assets/%s/balance", assetID)
+	err := s.client.RequestWithQuery(path, query.params(), &snapshot)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get historical balance: %w", err)
+	}
+	return &snapshot, nil
+}
+
+// GetAccountBalancesAt retrieves the balances of all assets of an account at
+// a timestamp or block height
+func (s *Service) GetAccountBalancesAt(ctx context.Context, accountID string, query *BalanceQuery) ([]*BalanceSnapshot, error) {
+	params := query.params()
+	params["account_id"] = accountID
+
+	var snapshots []*BalanceSnapshot
+	err := s.client.RequestWithQuery("/api/v1/assets/balances", params, &snapshots)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get historical account balances: %w", err)
+	}
+	return snapshots, nil
+}
+
+// BalanceHistoryRequest represents a time-series query over an asset's balance
+type BalanceHistoryRequest struct {
+	AssetID  string
+	From     time.Time
+	To       time.Time
+	Interval string // hour, day, month
+}
+
+// ListBalanceHistory retrieves balance snapshots of an asset over a time range
+func (s *Service) ListBalanceHistory(ctx context.Context, req *BalanceHistoryRequest) ([]*BalanceSnapshot, error) {
+	params := make(map[string]string)
+	if !req.From.IsZero() {
+		params["from"] = req.From.UTC().Format(time.RFC3339)
+	}
+	if !req.To.IsZero() {
+		params["to"] = req.To.UTC().Format(time.RFC3339)
+	}
+	params["interval"] = req.Interval
+
+	var snapshots []*BalanceSnapshot
+	path := fmt.Sprintf("/api/v1/assets/%s/balance-history", req.AssetID)
+	err := s.client.RequestWithQuery(path, params, &snapshots)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list balance history: %w", err)
+	}
+	return snapshots, nil
+}
@@ -0,0 +1,201 @@
+package asset
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/paratro/paratro-sdk-go/address"
+	"github.com/paratro/paratro-sdk-go/common"
+)
+
+// Asset types
+const (
+	AssetTypeNative = "NATIVE"
+	AssetTypeERC20  = "ERC20"
+	AssetTypeTRC20  = "TRC20"
+)
+
+var (
+	// ErrTokenNotFound is returned when a token is not in the catalog
+	ErrTokenNotFound = errors.New("token not found")
+	// ErrAmbiguousSymbol is returned when a symbol matches several tokens on a chain
+	ErrAmbiguousSymbol = errors.New("ambiguous token symbol")
+)
+
+// Token describes a token supported by the platform
+type Token struct {
+	Symbol          string         `json:"symbol"`
+	Name            string         `json:"name"`
+	Chain           common.Chain   `json:"chain"`
+	Network         common.Network `json:"network"`
+	AssetType       string         `json:"asset_type"` // NATIVE, ERC20, TRC20
+	ContractAddress string         `json:"contract_address,omitempty"`
+	Decimals        int            `json:"decimals"`
+}
+
+// ListTokensRequest represents a request to list supported tokens
+type ListTokensRequest struct {
+	Chain   common.Chain   `json:"chain,omitempty"`
+	Network common.Network `json:"network,omitempty"`
+}
+
+// ListTokens retrieves the catalog of supported tokens
+func (s *Service) ListTokens(ctx context.Context, req *ListTokensRequest) ([]*Token, error) {
+	params := make(map[string]string)
+
+	if req != nil {
+		params["chain"] = string(req.Chain)
+		params["network"] = string(req.Network)
+	}
+
+	var tokens []*Token
+	err := s.client.RequestWithQuery("/api/v1/assets/tokens", params, &tokens)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tokens: %w", err)
+	}
+	return tokens, nil
+}
+
+// Registry caches the token catalog per chain and network
+type Registry struct {
+	service *Service
+	ttl     time.Duration
+
+	mu      sync.Mutex
+	entries map[string]*registryEntry
+}
+
+type registryEntry struct {
+	tokens    []*Token
+	fetchedAt time.Time
+}
+
+// NewRegistry creates a token registry. Catalog pages are cached for ttl;
+// a zero ttl caches them for the lifetime of the registry.
+func NewRegistry(service *Service, ttl time.Duration) *Registry {
+	return &Registry{
+		service: service,
+		ttl:     ttl,
+		entries: make(map[string]*registryEntry),
+	}
+}
+
+// Tokens returns the supported tokens for a chain and network
+func (r *Registry) Tokens(ctx context.Context, chain common.Chain, network common.Network) ([]*Token, error) {
+	key := address.CanonicalChain(string(chain)) + ":" + strings.ToLower(string(network))
+
+	r.mu.Lock()
+	entry, ok := r.entries[key]
+	r.mu.Unlock()
+	if ok && (r.ttl == 0 || time.Since(entry.fetchedAt) < r.ttl) {
+		return entry.tokens, nil
+	}
+
+	tokens, err := r.service.ListTokens(ctx, &ListTokensRequest{Chain: chain, Network: network})
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	r.entries[key] = &registryEntry{tokens: tokens, fetchedAt: time.Now()}
+	r.mu.Unlock()
+	return tokens, nil
+}
+
+// Invalidate drops all cached catalog pages
+func (r *Registry) Invalidate() {
+	r.mu.Lock()
+	r.entries = make(map[string]*registryEntry)
+	r.mu.Unlock()
+}
+
+// ByContract finds a token by contract address
+func (r *Registry) ByContract(ctx context.Context, chain common.Chain, network common.Network, contract string) (*Token, error) {
+	tokens, err := r.Tokens(ctx, chain, network)
+	if err != nil {
+		return nil, err
+	}
+
+	want := NormalizeContract(chain, contract)
+	for _, t := range tokens {
+		if t.ContractAddress != "" && NormalizeContract(chain, t.ContractAddress) == want {
+			return t, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: contract %s on %s %s", ErrTokenNotFound, contract, chain, network)
+}
+
+// BySymbol returns every token with the given symbol
+func (r *Registry) BySymbol(ctx context.Context, chain common.Chain, network common.Network, symbol string) ([]*Token, error) {
+	tokens, err := r.Tokens(ctx, chain, network)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []*Token
+	for _, t := range tokens {
+		if strings.EqualFold(t.Symbol, symbol) {
+			matches = append(matches, t)
+		}
+	}
+	return matches, nil
+}
+
+// Resolve finds the single token with the given symbol, failing if the
+// symbol is unknown or ambiguous on the chain
+func (r *Registry) Resolve(ctx context.Context, chain common.Chain, network common.Network, symbol string) (*Token, error) {
+	matches, err := r.BySymbol(ctx, chain, network, symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("%w: %s on %s %s", ErrTokenNotFound, symbol, chain, network)
+	case 1:
+		return matches[0], nil
+	default:
+		return nil, fmt.Errorf("%w: %s matches %d tokens on %s %s, use a contract address",
+			ErrAmbiguousSymbol, symbol, len(matches), chain, network)
+	}
+}
+
+// Create validates the request against the catalog for the account's chain
+// and network, then creates the asset. If ContractAddress is set, the token
+// is looked up by contract; otherwise Symbol must identify a single token.
+func (r *Registry) Create(ctx context.Context, chain common.Chain, network common.Network, req *CreateAssetRequest) (*Asset, error) {
+	var token *Token
+	var err error
+	if req.ContractAddress != "" {
+		token, err = r.ByContract(ctx, chain, network, req.ContractAddress)
+	} else {
+		token, err = r.Resolve(ctx, chain, network, req.Symbol)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create asset: %w", err)
+	}
+
+	if req.Symbol != "" && !strings.EqualFold(req.Symbol, token.Symbol) {
+		return nil, fmt.Errorf("failed to create asset: contract %s is %s, not %s",
+			token.ContractAddress, token.Symbol, req.Symbol)
+	}
+
+	return r.service.Create(ctx, &CreateAssetRequest{
+		AccountID:       req.AccountID,
+		Symbol:          token.Symbol,
+		ContractAddress: token.ContractAddress,
+	})
+}
+
+// normalizeContract normalizes a contract address for comparison
+func NormalizeContract(chain common.Chain, contract string) string {
+	normalized, err := address.Normalize(string(chain), contract)
+	if err != nil {
+		return strings.ToLower(strings.TrimSpace(contract))
+	}
+	return normalized
+}
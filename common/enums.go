@@ -0,0 +1,139 @@
+package common
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/paratro/paratro-sdk-go/address"
+)
+
+// ErrUnknownValue is returned when parsing a value outside an enum's known set
+var ErrUnknownValue = errors.New("unknown value")
+
+// Chain identifies a blockchain by its ticker
+type Chain string
+
+// Chains supported by the gateway
+const (
+	ChainEthereum  Chain = "ETH"
+	ChainBSC       Chain = "BSC"
+	ChainPolygon   Chain = "POLYGON"
+	ChainArbitrum  Chain = "ARB"
+	ChainOptimism  Chain = "OP"
+	ChainAvalanche Chain = "AVAX"
+	ChainBase      Chain = "BASE"
+	ChainTron      Chain = "TRX"
+	ChainBitcoin   Chain = "BTC"
+	ChainXRP       Chain = "XRP"
+	ChainStellar   Chain = "XLM"
+	ChainTON       Chain = "TON"
+	ChainCosmos    Chain = "ATOM"
+	ChainEOS       Chain = "EOS"
+)
+
+var knownChains = map[Chain]bool{
+	ChainEthereum:  true,
+	ChainBSC:       true,
+	ChainPolygon:   true,
+	ChainArbitrum:  true,
+	ChainOptimism:  true,
+	ChainAvalanche: true,
+	ChainBase:      true,
+	ChainTron:      true,
+	ChainBitcoin:   true,
+	ChainXRP:       true,
+	ChainStellar:   true,
+	ChainTON:       true,
+	ChainCosmos:    true,
+	ChainEOS:       true,
+}
+
+// ParseChain returns the chain for a ticker or name such as "ETH" or
+// "ethereum"
+func ParseChain(s string) (Chain, error) {
+	c := Chain(address.CanonicalChain(s))
+	if !knownChains[c] {
+		return "", fmt.Errorf("%w: chain %q", ErrUnknownValue, s)
+	}
+	return c, nil
+}
+
+// IsKnown reports whether c is one of the Chain constants
+func (c Chain) IsKnown() bool {
+	return knownChains[c]
+}
+
+// String returns the chain ticker
+func (c Chain) String() string {
+	return string(c)
+}
+
+// Canonical returns the chain ticker for any spelling ParseChain accepts,
+// and c unchanged for unknown chains. Decoded chains keep the spelling the
+// API sent; compare them through Canonical or address.CanonicalChain.
+func (c Chain) Canonical() Chain {
+	return Chain(address.CanonicalChain(string(c)))
+}
+
+// Network identifies a chain's network
+type Network string
+
+// Networks
+const (
+	NetworkMainnet Network = "mainnet"
+	NetworkTestnet Network = "testnet"
+)
+
+// ParseNetwork returns the network for s, ignoring case
+func ParseNetwork(s string) (Network, error) {
+	n := Network(strings.ToLower(strings.TrimSpace(s)))
+	if !n.IsKnown() {
+		return "", fmt.Errorf("%w: network %q", ErrUnknownValue, s)
+	}
+	return n, nil
+}
+
+// IsKnown reports whether n is one of the Network constants
+func (n Network) IsKnown() bool {
+	return n == NetworkMainnet || n == NetworkTestnet
+}
+
+// String returns the network name
+func (n Network) String() string {
+	return string(n)
+}
+
+// ParseEnum returns the member of values matching s, ignoring case. Enum
+// types in the service packages build their Parse functions on it.
+func ParseEnum(name, s string, values ...string) (string, error) {
+	for _, v := range values {
+		if strings.EqualFold(strings.TrimSpace(s), v) {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("%w: %s %q", ErrUnknownValue, name, s)
+}
+
+// UnmarshalEnum decodes a JSON string into the member of values matching it,
+// ignoring case. Unknown values are returned as sent, so that values added
+// by the API after this SDK version do not break decoding.
+func UnmarshalEnum(data []byte, values ...string) (string, error) {
+	s, err := unmarshalEnum(data)
+	if err != nil {
+		return "", err
+	}
+	if v, err := ParseEnum("", s, values...); err == nil {
+		return v, nil
+	}
+	return s, nil
+}
+
+func unmarshalEnum(data []byte) (string, error) {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return "", err
+	}
+	return s, nil
+}
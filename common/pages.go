@@ -0,0 +1,20 @@
+package common
+
+// DefaultPageSize is the page size ListAll callers use unless they need another
+const DefaultPageSize = 100
+
+// ListAll fetches pages 1, 2, ... of pageSize items with list and returns
+// every item, stopping after the first page holding fewer than pageSize items
+func ListAll[T any](pageSize int, list func(page, pageSize int) ([]T, error)) ([]T, error) {
+	var all []T
+	for page := 1; ; page++ {
+		items, err := list(page, pageSize)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, items...)
+		if len(items) < pageSize {
+			return all, nil
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Time is a timestamp decoded from any format the API returns: RFC3339,
+// RFC3339 with fractional seconds, or unix seconds as a number or numeric
+// string. It remembers the encoding it was decoded from and marshals back to
+// it unchanged unless the time is modified.
+type Time struct {
+	time.Time
+	raw    string    // JSON the value was decoded from
+	parsed time.Time // Value decoded from raw, to detect modification
+}
+
+// NewTime wraps t; it marshals as RFC3339 with fractional seconds
+func NewTime(t time.Time) Time {
+	return Time{Time: t}
+}
+
+// ParseTime parses an RFC3339 timestamp or unix seconds, which may have a
+// fractional part. The result is in UTC.
+func ParseTime(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if t, err := time.Parse(time.RFC3339Nano, s); err == nil {
+		return t.UTC(), nil
+	}
+
+	sec, frac, _ := strings.Cut(s, ".")
+	secs, err := strconv.ParseInt(sec, 10, 64)
+	if err != nil || len(frac) > 9 {
+		return time.Time{}, fmt.Errorf("invalid timestamp %q", s)
+	}
+	var nanos int64
+	if frac != "" {
+		if nanos, err = strconv.ParseInt(frac+strings.Repeat("0", 9-len(frac)), 10, 64); err != nil {
+			return time.Time{}, fmt.Errorf("invalid timestamp %q", s)
+		}
+	}
+	return time.Unix(secs, nanos).UTC(), nil
+}
+
+// UnmarshalJSON decodes a string or number timestamp. Null and the empty
+// string decode as the zero time.
+func (t *Time) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if data[0] == '"' {
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+	}
+
+	var parsed time.Time
+	if s != "" && s != "null" {
+		var err error
+		if parsed, err = ParseTime(s); err != nil {
+			return err
+		}
+	}
+	t.Time, t.parsed = parsed, parsed
+	t.raw = string(data)
+	return nil
+}
+
+// MarshalJSON returns the decoded JSON when the time is unchanged, and RFC3339
+// with fractional seconds otherwise. The zero time marshals as null.
+func (t Time) MarshalJSON() ([]byte, error) {
+	if t.raw != "" && t.Time.Equal(t.parsed) {
+		return []byte(t.raw), nil
+	}
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(t.Time.Format(time.RFC3339Nano))
+}
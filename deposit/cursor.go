@@ -0,0 +1,129 @@
+package deposit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/paratro/paratro-sdk-go/common"
+)
+
+// Cursor is the persisted state of a Watcher
+type Cursor struct {
+	// Since is the creation time from which transactions are re-read
+	Since time.Time `json:"since"`
+
+	// Credited holds deposits already emitted, by TxID, until they are
+	// final and older than Since
+	Credited map[string]*CreditedDeposit `json:"credited"`
+}
+
+// CreditedDeposit records an emitted deposit
+type CreditedDeposit struct {
+	Chain     common.Chain `json:"chain"`
+	TxHash    string       `json:"tx_hash"`
+	CreatedAt time.Time    `json:"created_at"`
+	Final     bool         `json:"final"` // Past the reorg window
+}
+
+// CursorStore persists a watcher's cursor
+type CursorStore interface {
+	// Load returns the saved cursor, or nil if none was saved
+	Load(ctx context.Context) (*Cursor, error)
+
+	// Save replaces the saved cursor
+	Save(ctx context.Context, cursor *Cursor) error
+}
+
+// MemoryCursorStore keeps the cursor in memory
+type MemoryCursorStore struct {
+	mu     sync.Mutex
+	cursor []byte
+}
+
+// NewMemoryCursorStore creates an empty in-memory cursor store
+func NewMemoryCursorStore() *MemoryCursorStore {
+	return &MemoryCursorStore{}
+}
+
+// Load implements CursorStore
+func (m *MemoryCursorStore) Load(ctx context.Context) (*Cursor, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.cursor == nil {
+		return nil, nil
+	}
+	var cursor Cursor
+	if err := json.Unmarshal(m.cursor, &cursor); err != nil {
+		return nil, err
+	}
+	return &cursor, nil
+}
+
+// Save implements CursorStore
+func (m *MemoryCursorStore) Save(ctx context.Context, cursor *Cursor) error {
+	data, err := json.Marshal(cursor)
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.cursor = data
+	return nil
+}
+
+// FileCursorStore keeps the cursor in a JSON file, replaced atomically on save
+type FileCursorStore struct {
+	path string
+}
+
+// NewFileCursorStore creates a cursor store backed by the file at path
+func NewFileCursorStore(path string) *FileCursorStore {
+	return &FileCursorStore{path: path}
+}
+
+// Load implements CursorStore
+func (f *FileCursorStore) Load(ctx context.Context) (*Cursor, error) {
+	data, err := os.ReadFile(f.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var cursor Cursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, err
+	}
+	return &cursor, nil
+}
+
+// Save implements CursorStore
+func (f *FileCursorStore) Save(ctx context.Context, cursor *Cursor) error {
+	data, err := json.Marshal(cursor)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(f.path), filepath.Base(f.path)+".tmp*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), f.path)
+}
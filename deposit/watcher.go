@@ -0,0 +1,250 @@
+// Package deposit watches RECEIVE transactions and reports deposits once
+// they reach a per-chain confirmation count. It deduplicates by TxID,
+// persists a cursor so restarts do not re-emit, and reports deposits that a
+// chain reorganization pushed back below the threshold.
+package deposit
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/paratro/paratro-sdk-go/common"
+	"github.com/paratro/paratro-sdk-go/transaction"
+)
+
+// Event types
+const (
+	EventCredited = "CREDITED" // The deposit reached its confirmation threshold
+	EventReverted = "REVERTED" // A credited deposit fell below the threshold, failed or disappeared
+)
+
+// Event reports a change in a deposit's credit state
+type Event struct {
+	Type string // CREDITED, REVERTED
+
+	// Transaction is the deposit as listed. For a deposit no longer listed
+	// it holds only the TxID, chain, hash and creation time.
+	Transaction *transaction.Transaction
+}
+
+// DefaultConfirmations are the thresholds used for chains missing from
+// Watcher.Confirmations; other chains need a single confirmation
+var DefaultConfirmations = map[common.Chain]int{
+	common.ChainBitcoin:  3,
+	common.ChainEthereum: 12,
+	common.ChainTron:     19,
+}
+
+// Watcher polls RECEIVE transactions and emits deposit events
+type Watcher struct {
+	transactions *transaction.Service
+	store        CursorStore
+
+	// WalletID and AccountID restrict the watcher; empty means all
+	WalletID  string
+	AccountID string
+
+	// Confirmations overrides DefaultConfirmations per chain; keys may use
+	// any spelling common.ParseChain accepts
+	Confirmations map[common.Chain]int
+
+	// ReorgDepth is how many confirmations past the threshold a credited
+	// deposit is still watched for reorgs; zero uses the threshold itself
+	ReorgDepth int
+
+	// Since is where a watcher without a saved cursor starts; zero means now
+	Since time.Time
+
+	// OnError receives errors from Run; it may be nil
+	OnError func(error)
+}
+
+// NewWatcher creates a deposit watcher that persists its cursor in store
+func NewWatcher(transactions *transaction.Service, store CursorStore) *Watcher {
+	return &Watcher{
+		transactions: transactions,
+		store:        store,
+	}
+}
+
+// threshold returns the confirmations required to credit a deposit on chain
+func (w *Watcher) threshold(chain common.Chain) int {
+	chain = chain.Canonical()
+	for c, n := range w.Confirmations {
+		if c.Canonical() == chain {
+			return n
+		}
+	}
+	for c, n := range DefaultConfirmations {
+		if c.Canonical() == chain {
+			return n
+		}
+	}
+	return 1
+}
+
+// final reports whether a credited deposit is deep enough to stop watching
+func (w *Watcher) final(tx *transaction.Transaction) bool {
+	threshold := w.threshold(tx.Chain)
+	depth := w.ReorgDepth
+	if depth <= 0 {
+		depth = threshold
+	}
+	return tx.Confirmations >= threshold+depth
+}
+
+// Poll checks for deposit changes once, sends the resulting events to out
+// and saves the cursor. Delivery is at-least-once: if the process stops
+// between sending and saving, events are sent again after restart, so
+// consumers should be idempotent by TxID.
+func (w *Watcher) Poll(ctx context.Context, out chan<- *Event) error {
+	cursor, err := w.store.Load(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load deposit cursor: %w", err)
+	}
+	if cursor == nil {
+		since := w.Since
+		if since.IsZero() {
+			since = time.Now()
+		}
+		cursor = &Cursor{Since: since.UTC()}
+	}
+	if cursor.Credited == nil {
+		cursor.Credited = make(map[string]*CreditedDeposit)
+	}
+
+	listedSince := cursor.Since
+	txs, err := w.listSince(ctx, listedSince)
+	if err != nil {
+		return err
+	}
+
+	var events []*Event
+	var oldestOpen, newest time.Time
+	seen := make(map[string]bool, len(txs))
+	for _, tx := range txs {
+		seen[tx.TxID] = true
+		if tx.CreatedAt.IsZero() {
+			return fmt.Errorf("transaction %s has no created_at", tx.TxID)
+		}
+		createdAt := tx.CreatedAt.Time
+		if createdAt.After(newest) {
+			newest = createdAt
+		}
+
+		failed := isFailed(tx)
+		credited, wasCredited := cursor.Credited[tx.TxID]
+		reached := !failed && tx.Confirmations >= w.threshold(tx.Chain)
+
+		switch {
+		case wasCredited && !reached:
+			events = append(events, &Event{Type: EventReverted, Transaction: tx})
+			delete(cursor.Credited, tx.TxID)
+		case wasCredited:
+			credited.Final = w.final(tx)
+		case reached:
+			events = append(events, &Event{Type: EventCredited, Transaction: tx})
+			cursor.Credited[tx.TxID] = &CreditedDeposit{
+				Chain:     tx.Chain,
+				TxHash:    tx.TxHash,
+				CreatedAt: createdAt,
+				Final:     w.final(tx),
+			}
+		}
+
+		// Anything that may still change keeps the window open
+		if c, ok := cursor.Credited[tx.TxID]; (ok && !c.Final) || (!ok && !failed) {
+			if oldestOpen.IsZero() || createdAt.Before(oldestOpen) {
+				oldestOpen = createdAt
+			}
+		}
+	}
+
+	// A reorg can also drop a credited deposit from the listing entirely
+	var dropped []string
+	for id, c := range cursor.Credited {
+		if !seen[id] && !c.Final && !c.CreatedAt.Before(listedSince) {
+			dropped = append(dropped, id)
+		}
+	}
+	sort.Strings(dropped)
+	for _, id := range dropped {
+		c := cursor.Credited[id]
+		events = append(events, &Event{Type: EventReverted, Transaction: &transaction.Transaction{
+			TxID:      id,
+			Chain:     c.Chain,
+			TxHash:    c.TxHash,
+			TxType:    transaction.TxTypeReceive,
+			CreatedAt: common.NewTime(c.CreatedAt),
+		}})
+		delete(cursor.Credited, id)
+	}
+
+	switch {
+	case !oldestOpen.IsZero():
+		cursor.Since = oldestOpen
+	case !newest.IsZero():
+		cursor.Since = newest
+	}
+	// Final deposits inside the window are kept so they are not re-emitted.
+	// The window start is sent with whole-second precision, so a deposit in
+	// the same second as Since is listed again and must be kept too.
+	for id, c := range cursor.Credited {
+		if c.Final && c.CreatedAt.Truncate(time.Second).Before(cursor.Since.Truncate(time.Second)) {
+			delete(cursor.Credited, id)
+		}
+	}
+
+	for _, e := range events {
+		select {
+		case out <- e:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	if err := w.store.Save(ctx, cursor); err != nil {
+		return fmt.Errorf("failed to save deposit cursor: %w", err)
+	}
+	return nil
+}
+
+// Run polls every interval until the context is cancelled
+func (w *Watcher) Run(ctx context.Context, interval time.Duration, out chan<- *Event) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := w.Poll(ctx, out); err != nil && w.OnError != nil && ctx.Err() == nil {
+			w.OnError(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// listSince returns every RECEIVE transaction created at or after since
+func (w *Watcher) listSince(ctx context.Context, since time.Time) ([]*transaction.Transaction, error) {
+	return w.transactions.ListAll(ctx, &transaction.ListTransactionsRequest{
+		WalletID:    w.WalletID,
+		AccountID:   w.AccountID,
+		TxType:      transaction.TxTypeReceive,
+		CreatedFrom: since,
+		SortOrder:   transaction.SortAsc,
+	})
+}
+
+// isFailed reports whether a deposit can no longer confirm
+func isFailed(tx *transaction.Transaction) bool {
+	switch tx.Status {
+	case transaction.StatusFailed, transaction.StatusDropped, transaction.StatusReplaced:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,181 @@
+// Package derivation parses and formats BIP-32 derivation paths and provides
+// helpers for the BIP-44 family of layouts used by MPC accounts.
+package derivation
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/paratro/paratro-sdk-go/address"
+)
+
+// HardenedOffset is added to a path component to mark it hardened
+const HardenedOffset uint32 = 0x80000000
+
+// Purposes defined by BIP-44, BIP-49, BIP-84 and BIP-86
+const (
+	Purpose44 uint32 = 44
+	Purpose49 uint32 = 49
+	Purpose84 uint32 = 84
+	Purpose86 uint32 = 86
+)
+
+// SLIP-44 coin types for the supported chains
+const (
+	CoinTypeBTC        uint32 = 0
+	CoinTypeBTCTestnet uint32 = 1
+	CoinTypeETH        uint32 = 60
+	CoinTypeTRX        uint32 = 195
+)
+
+// ErrInvalidPath is returned for malformed or unexpected derivation paths
+var ErrInvalidPath = errors.New("invalid derivation path")
+
+// Path is a BIP-32 derivation path. Hardened components include HardenedOffset.
+type Path []uint32
+
+// Parse parses a path such as "m/44'/60'/0'/0/5". Hardened components may be
+// marked with ', h or H.
+func Parse(s string) (Path, error) {
+	s = strings.TrimSpace(s)
+	parts := strings.Split(s, "/")
+	if len(parts) == 0 || (parts[0] != "m" && parts[0] != "M") {
+		return nil, fmt.Errorf("%w: %q must start with m/", ErrInvalidPath, s)
+	}
+
+	path := make(Path, 0, len(parts)-1)
+	for _, part := range parts[1:] {
+		hardened := false
+		if strings.HasSuffix(part, "'") || strings.HasSuffix(part, "h") || strings.HasSuffix(part, "H") {
+			hardened = true
+			part = part[:len(part)-1]
+		}
+
+		n, err := strconv.ParseUint(part, 10, 32)
+		if err != nil || uint32(n) >= HardenedOffset {
+			return nil, fmt.Errorf("%w: bad component %q in %q", ErrInvalidPath, part, s)
+		}
+
+		c := uint32(n)
+		if hardened {
+			c += HardenedOffset
+		}
+		path = append(path, c)
+	}
+	return path, nil
+}
+
+// New builds a five-level path purpose'/coin'/account'/change/index
+func New(purpose, coinType, account, change, index uint32) Path {
+	return Path{
+		purpose + HardenedOffset,
+		coinType + HardenedOffset,
+		account + HardenedOffset,
+		change,
+		index,
+	}
+}
+
+// BIP44 builds a BIP-44 path m/44'/coin'/account'/change/index
+func BIP44(coinType, account, change, index uint32) Path {
+	return New(Purpose44, coinType, account, change, index)
+}
+
+// BIP84 builds a BIP-84 native segwit path m/84'/coin'/account'/change/index
+func BIP84(coinType, account, change, index uint32) Path {
+	return New(Purpose84, coinType, account, change, index)
+}
+
+// String formats the path using ' for hardened components
+func (p Path) String() string {
+	var b strings.Builder
+	b.WriteString("m")
+	for _, c := range p {
+		b.WriteString("/")
+		if c >= HardenedOffset {
+			b.WriteString(strconv.FormatUint(uint64(c-HardenedOffset), 10))
+			b.WriteString("'")
+		} else {
+			b.WriteString(strconv.FormatUint(uint64(c), 10))
+		}
+	}
+	return b.String()
+}
+
+// IsBIP44Layout reports whether the path has the purpose'/coin'/account'/change/index shape
+func (p Path) IsBIP44Layout() bool {
+	return len(p) == 5 &&
+		p[0] >= HardenedOffset && p[1] >= HardenedOffset && p[2] >= HardenedOffset &&
+		p[3] < HardenedOffset && p[4] < HardenedOffset
+}
+
+// Purpose returns the unhardened purpose of a BIP-44 layout path
+func (p Path) Purpose() uint32 { return p.level(0) }
+
+// CoinType returns the unhardened coin type of a BIP-44 layout path
+func (p Path) CoinType() uint32 { return p.level(1) }
+
+// Account returns the unhardened account of a BIP-44 layout path
+func (p Path) Account() uint32 { return p.level(2) }
+
+// Change returns the change level of a BIP-44 layout path (0 external, 1 internal)
+func (p Path) Change() uint32 { return p.level(3) }
+
+// Index returns the address index of a BIP-44 layout path
+func (p Path) Index() uint32 { return p.level(4) }
+
+func (p Path) level(i int) uint32 {
+	if i >= len(p) {
+		return 0
+	}
+	return p[i] &^ HardenedOffset
+}
+
+// CoinTypeForChain returns the SLIP-44 coin type used for a chain and network
+func CoinTypeForChain(chain, network string) (uint32, error) {
+	family, err := address.ChainFamily(chain)
+	if err != nil {
+		return 0, err
+	}
+
+	switch family {
+	case address.FamilyEVM:
+		return CoinTypeETH, nil
+	case address.FamilyTron:
+		return CoinTypeTRX, nil
+	default:
+		if network != "" && !strings.EqualFold(network, address.NetworkMainnet) {
+			return CoinTypeBTCTestnet, nil
+		}
+		return CoinTypeBTC, nil
+	}
+}
+
+// ValidateForChain checks that a path follows the BIP-44 layout with a purpose
+// and coin type appropriate for the chain and network
+func ValidateForChain(p Path, chain, network string) error {
+	if !p.IsBIP44Layout() {
+		return fmt.Errorf("%w: %s is not a BIP-44 layout path", ErrInvalidPath, p)
+	}
+
+	coinType, err := CoinTypeForChain(chain, network)
+	if err != nil {
+		return err
+	}
+	if p.CoinType() != coinType {
+		return fmt.Errorf("%w: coin type %d does not match %s (expected %d)", ErrInvalidPath, p.CoinType(), chain, coinType)
+	}
+
+	family, _ := address.ChainFamily(chain)
+	switch p.Purpose() {
+	case Purpose44:
+		return nil
+	case Purpose49, Purpose84, Purpose86:
+		if family == address.FamilyBTC {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: purpose %d is not used on %s", ErrInvalidPath, p.Purpose(), chain)
+}
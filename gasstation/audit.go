@@ -0,0 +1,145 @@
+package gasstation
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/paratro/paratro-sdk-go/amount"
+	"github.com/paratro/paratro-sdk-go/common"
+)
+
+// Top-up outcomes
+const (
+	OutcomePending   = "PENDING" // Intent recorded before the transfer is submitted
+	OutcomeSubmitted = "SUBMITTED"
+	OutcomeSkipped   = "SKIPPED" // A cap or cooldown prevented the top-up
+	OutcomeFailed    = "FAILED"
+)
+
+// TopUp is an audit record of one top-up decision
+type TopUp struct {
+	Time          time.Time     `json:"time"`
+	AccountID     string        `json:"account_id"`
+	Chain         common.Chain  `json:"chain"`
+	Address       string        `json:"address,omitempty"`
+	BalanceBefore amount.Amount `json:"balance_before"` // Native balance that triggered the top-up
+	Amount        amount.Amount `json:"amount"`
+	Outcome       string        `json:"outcome"` // PENDING, SUBMITTED, SKIPPED, FAILED
+	Reason        string        `json:"reason,omitempty"`
+	TxID          string        `json:"tx_id,omitempty"`
+
+	// IdempotencyKey identifies the transfer; a PENDING record and the
+	// record of its outcome share it
+	IdempotencyKey string `json:"idempotency_key,omitempty"`
+}
+
+// AuditLog records top-ups; the station also reads it back to enforce
+// daily caps and cooldowns
+type AuditLog interface {
+	// Record appends a top-up record
+	Record(ctx context.Context, topUp *TopUp) error
+
+	// Since returns the records at or after t, oldest first
+	Since(ctx context.Context, t time.Time) ([]*TopUp, error)
+}
+
+// MemoryAuditLog keeps top-up records in memory
+type MemoryAuditLog struct {
+	mu      sync.RWMutex
+	records []*TopUp
+}
+
+// NewMemoryAuditLog creates an empty in-memory audit log
+func NewMemoryAuditLog() *MemoryAuditLog {
+	return &MemoryAuditLog{}
+}
+
+// Record appends a top-up record
+func (m *MemoryAuditLog) Record(ctx context.Context, topUp *TopUp) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.records = append(m.records, topUp)
+	return nil
+}
+
+// Since returns the records at or after t, oldest first
+func (m *MemoryAuditLog) Since(ctx context.Context, t time.Time) ([]*TopUp, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return since(m.records, t), nil
+}
+
+// JSONLinesAuditLog appends top-up records to a file, one JSON object per line
+type JSONLinesAuditLog struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewJSONLinesAuditLog creates an audit log backed by the file at path. The
+// file is created on the first Record.
+func NewJSONLinesAuditLog(path string) *JSONLinesAuditLog {
+	return &JSONLinesAuditLog{path: path}
+}
+
+// Record appends a top-up record to the file
+func (j *JSONLinesAuditLog) Record(ctx context.Context, topUp *TopUp) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	f, err := os.OpenFile(j.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to open audit log: %w", err)
+	}
+	defer f.Close()
+
+	if err := json.NewEncoder(f).Encode(topUp); err != nil {
+		return fmt.Errorf("failed to write audit record: %w", err)
+	}
+	return nil
+}
+
+// Since returns the records at or after t, oldest first
+func (j *JSONLinesAuditLog) Since(ctx context.Context, t time.Time) ([]*TopUp, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	f, err := os.Open(j.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit log: %w", err)
+	}
+	defer f.Close()
+
+	var records []*TopUp
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var record TopUp
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return nil, fmt.Errorf("failed to decode audit record: %w", err)
+		}
+		records = append(records, &record)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read audit log: %w", err)
+	}
+	return since(records, t), nil
+}
+
+func since(records []*TopUp, t time.Time) []*TopUp {
+	var out []*TopUp
+	for _, r := range records {
+		if !r.Time.Before(t) {
+			out = append(out, r)
+		}
+	}
+	return out
+}
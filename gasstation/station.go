@@ -0,0 +1,282 @@
+// Package gasstation keeps token-holding accounts supplied with native
+// currency for gas. It tops accounts up from a per-chain funding account
+// when their native balance falls below a threshold, within per-top-up and
+// daily caps, and records every decision in an audit log.
+//
+// Each top-up is logged as PENDING before it is submitted. Until it is
+// logged as SUBMITTED, for example because the process stopped or the
+// request failed, every check resubmits it with the same idempotency key,
+// so the top-up is sent at most once.
+package gasstation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/paratro/paratro-sdk-go/account"
+	"github.com/paratro/paratro-sdk-go/address"
+	"github.com/paratro/paratro-sdk-go/amount"
+	"github.com/paratro/paratro-sdk-go/asset"
+	"github.com/paratro/paratro-sdk-go/common"
+	"github.com/paratro/paratro-sdk-go/transaction"
+)
+
+// Station monitors the accounts of one wallet
+type Station struct {
+	accounts     *account.Service
+	assets       *asset.Service
+	transactions *transaction.Service
+	log          AuditLog
+
+	WalletID string
+
+	// Funding configures top-ups per chain; accounts on chains without an
+	// entry, or on another network than the funding account, are not topped up
+	Funding map[common.Chain]*Funding
+
+	// Cooldown skips accounts topped up more recently, giving the previous
+	// top-up time to confirm; zero means 10 minutes
+	Cooldown time.Duration
+
+	// OnError receives errors from Run; it may be nil
+	OnError func(error)
+}
+
+// Funding configures top-ups on one chain. Amounts are in the chain's
+// native currency.
+type Funding struct {
+	AccountID string // Pays the top-ups
+
+	// MinBalance triggers a top-up when an account holding tokens has less
+	// native currency; TargetBalance is the balance a top-up restores
+	MinBalance    amount.Amount
+	TargetBalance amount.Amount
+
+	// MaxTopUp caps a single top-up and DailyCap the total sent on the chain
+	// in any 24 hours; zero disables a cap
+	MaxTopUp amount.Amount
+	DailyCap amount.Amount
+}
+
+// NewStation creates a gas station that records top-ups in log
+func NewStation(accounts *account.Service, assets *asset.Service, transactions *transaction.Service, log AuditLog) *Station {
+	return &Station{
+		accounts:     accounts,
+		assets:       assets,
+		transactions: transactions,
+		log:          log,
+		Funding:      make(map[common.Chain]*Funding),
+	}
+}
+
+// funder is a chain's funding config resolved against the wallet
+type funder struct {
+	*Funding
+	account   *account.Account
+	asset     *asset.Asset // Native asset of the funding account
+	sentToday amount.Amount
+}
+
+// CheckOnce tops up every account that holds tokens and is low on native
+// currency, and returns the audit records written
+func (s *Station) CheckOnce(ctx context.Context) ([]*TopUp, error) {
+	now := time.Now().UTC()
+	cooldown := s.Cooldown
+	if cooldown <= 0 {
+		cooldown = 10 * time.Minute
+	}
+
+	list, err := s.accounts.ListAll(ctx, &account.ListAccountsRequest{WalletID: s.WalletID})
+	if err != nil {
+		return nil, err
+	}
+	accounts := make(map[string]*account.Account, len(list))
+	for i := range list {
+		accounts[list[i].AccountID] = &list[i]
+	}
+	assets, err := s.assets.ListAll(ctx, &asset.ListAssetsRequest{WalletID: s.WalletID})
+	if err != nil {
+		return nil, err
+	}
+	recent, err := s.log.Since(ctx, now.Add(-24*time.Hour))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read audit log: %w", err)
+	}
+
+	natives := make(map[string]*asset.Asset)
+	holdsTokens := make(map[string]bool)
+	var order []string
+	for _, a := range assets {
+		if a.Status != "" && a.Status != asset.StatusActive {
+			continue
+		}
+		if a.AssetType == asset.AssetTypeNative {
+			natives[a.AccountID] = a
+		} else if a.Balance.Sign() > 0 && !holdsTokens[a.AccountID] {
+			holdsTokens[a.AccountID] = true
+			order = append(order, a.AccountID)
+		}
+	}
+
+	funders := make(map[common.Chain]*funder)
+	for chain, f := range s.Funding {
+		acct := accounts[f.AccountID]
+		if acct == nil {
+			return nil, fmt.Errorf("funding account %s for %s not found in wallet", f.AccountID, chain)
+		}
+		if natives[f.AccountID] == nil {
+			return nil, fmt.Errorf("funding account %s for %s has no native asset", f.AccountID, chain)
+		}
+		funders[common.Chain(address.CanonicalChain(string(chain)))] = &funder{
+			Funding:   f,
+			account:   acct,
+			asset:     natives[f.AccountID],
+			sentToday: amount.Zero(0),
+		}
+	}
+
+	// Intents not yet logged as SUBMITTED may or may not have been sent; they
+	// are retried with their key and count towards the daily cap meanwhile
+	pending := make(map[string]*TopUp)
+	for _, r := range recent {
+		if r.Outcome == OutcomePending {
+			pending[r.AccountID] = r
+		} else if p := pending[r.AccountID]; p != nil && p.IdempotencyKey == r.IdempotencyKey && r.Outcome == OutcomeSubmitted {
+			delete(pending, r.AccountID)
+		}
+	}
+
+	lastTopUp := make(map[string]time.Time)
+	for _, r := range recent {
+		unresolved := r.Outcome == OutcomePending && pending[r.AccountID] == r
+		if r.Outcome != OutcomeSubmitted && !unresolved {
+			continue
+		}
+		if f := funders[common.Chain(address.CanonicalChain(string(r.Chain)))]; f != nil {
+			f.sentToday = f.sentToday.Add(r.Amount)
+		}
+		if r.Outcome == OutcomeSubmitted && r.Time.After(lastTopUp[r.AccountID]) {
+			lastTopUp[r.AccountID] = r.Time
+		}
+	}
+
+	var records []*TopUp
+	for _, accountID := range order {
+		acct := accounts[accountID]
+		if acct == nil {
+			continue
+		}
+		chain := common.Chain(address.CanonicalChain(string(acct.Chain)))
+		f := funders[chain]
+		if f == nil || accountID == f.AccountID || !strings.EqualFold(string(acct.Network), string(f.account.Network)) {
+			continue
+		}
+
+		if p := pending[accountID]; p != nil {
+			// Resubmit the interrupted top-up; the server deduplicates it
+			record := *p
+			record.Time, record.Reason, record.TxID = now, "", ""
+			if err := s.submit(ctx, f, acct, &record, false); err != nil {
+				return records, err
+			}
+			records = append(records, &record)
+			continue
+		}
+
+		balance := amount.Zero(0)
+		if native := natives[accountID]; native != nil {
+			balance = native.Balance
+		}
+		if balance.Cmp(f.MinBalance) >= 0 {
+			continue
+		}
+
+		record := &TopUp{
+			Time:          now,
+			AccountID:     accountID,
+			Chain:         chain,
+			Address:       acct.Address,
+			BalanceBefore: balance,
+			Amount:        f.TargetBalance.Sub(balance),
+		}
+		if !f.MaxTopUp.IsZero() && record.Amount.Cmp(f.MaxTopUp) > 0 {
+			record.Amount = f.MaxTopUp
+		}
+
+		switch {
+		case now.Sub(lastTopUp[accountID]) < cooldown:
+			record.Outcome, record.Reason = OutcomeSkipped, "cooldown"
+		case !f.DailyCap.IsZero() && f.sentToday.Add(record.Amount).Cmp(f.DailyCap) > 0:
+			record.Outcome, record.Reason = OutcomeSkipped, "daily cap reached"
+		case record.Amount.Sign() <= 0:
+			record.Outcome, record.Reason = OutcomeSkipped, "target balance is not above the current balance"
+		default:
+			record.IdempotencyKey = fmt.Sprintf("gas-%s-%d", accountID, now.UnixNano())
+			if err := s.submit(ctx, f, acct, record, true); err != nil {
+				return records, err
+			}
+			f.sentToday = f.sentToday.Add(record.Amount)
+			records = append(records, record)
+			continue
+		}
+
+		if err := s.log.Record(ctx, record); err != nil {
+			return records, fmt.Errorf("failed to write audit log: %w", err)
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// submit sends one top-up and logs its outcome. A new top-up is first
+// logged as PENDING, so that it is resubmitted with the same key if the
+// outcome never reaches the log.
+func (s *Station) submit(ctx context.Context, f *funder, acct *account.Account, record *TopUp, logIntent bool) error {
+	if logIntent {
+		intent := *record
+		intent.Outcome = OutcomePending
+		if err := s.log.Record(ctx, &intent); err != nil {
+			return fmt.Errorf("failed to write audit log: %w", err)
+		}
+	}
+
+	tx, err := s.transactions.Transfer(ctx, &transaction.CreateTransferRequest{
+		AccountID:      f.AccountID,
+		AssetID:        f.asset.AssetID,
+		ToAddress:      acct.Address,
+		Amount:         record.Amount,
+		Chain:          acct.Chain,
+		Network:        acct.Network,
+		IdempotencyKey: record.IdempotencyKey,
+	})
+	if err != nil {
+		record.Outcome, record.Reason = OutcomeFailed, err.Error()
+	} else {
+		record.Outcome, record.TxID = OutcomeSubmitted, tx.TxID
+	}
+
+	if err := s.log.Record(ctx, record); err != nil {
+		return fmt.Errorf("failed to write audit log: %w", err)
+	}
+	return nil
+}
+
+// Run checks balances every interval until the context is cancelled
+func (s *Station) Run(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.CheckOnce(ctx); err != nil && s.OnError != nil && ctx.Err() == nil {
+			s.OnError(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -4,11 +4,15 @@ import (
 	"fmt"
 
 	"github.com/paratro/paratro-sdk-go/account"
+	"github.com/paratro/paratro-sdk-go/addressbook"
+	"github.com/paratro/paratro-sdk-go/approval"
 	"github.com/paratro/paratro-sdk-go/asset"
 	"github.com/paratro/paratro-sdk-go/auth"
 	"github.com/paratro/paratro-sdk-go/common"
 	"github.com/paratro/paratro-sdk-go/configuration"
+	"github.com/paratro/paratro-sdk-go/signing"
 	"github.com/paratro/paratro-sdk-go/transaction"
+	"github.com/paratro/paratro-sdk-go/utxo"
 	"github.com/paratro/paratro-sdk-go/wallet"
 )
 
@@ -23,6 +27,10 @@ type Client struct {
 	Account     *account.Service
 	Asset       *asset.Service
 	Transaction *transaction.Service
+	Signing     *signing.Service
+	Approval    *approval.Service
+	AddressBook *addressbook.Service
+	UTXO        *utxo.Service
 }
 
 // NewClient creates a new MPC SDK client
@@ -43,6 +51,8 @@ func NewClient(apiKey, apiSecret string, config *configuration.Config) (*Client,
 	// Create API client
 	apiClient := common.NewClient(config.BaseURL, tokenManager)
 
+	transactions := transaction.NewService(apiClient)
+
 	// Create client with services
 	client := &Client{
 		config:       config,
@@ -51,7 +61,11 @@ func NewClient(apiKey, apiSecret string, config *configuration.Config) (*Client,
 		Wallet:       wallet.NewService(apiClient),
 		Account:      account.NewService(apiClient),
 		Asset:        asset.NewService(apiClient),
-		Transaction:  transaction.NewService(apiClient),
+		Transaction:  transactions,
+		Signing:      signing.NewService(apiClient),
+		Approval:     approval.NewService(apiClient),
+		AddressBook:  addressbook.NewService(apiClient, transactions),
+		UTXO:         utxo.NewService(apiClient, transactions),
 	}
 
 	return client, nil
@@ -0,0 +1,206 @@
+package signing
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/paratro/paratro-sdk-go/abi"
+	"github.com/paratro/paratro-sdk-go/internal/keccak"
+)
+
+// TypedDataField is a member of an EIP-712 struct type
+type TypedDataField struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// TypedData is an EIP-712 typed data payload in the eth_signTypedData_v4 format
+type TypedData struct {
+	Types       map[string][]TypedDataField `json:"types"`
+	PrimaryType string                      `json:"primaryType"`
+	Domain      map[string]interface{}      `json:"domain"`
+	Message     map[string]interface{}      `json:"message"`
+}
+
+// UnmarshalJSON decodes numbers in the domain and message as json.Number,
+// so integers above 2^53 keep their precision
+func (td *TypedData) UnmarshalJSON(data []byte) error {
+	type typedData TypedData
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	return dec.Decode((*typedData)(td))
+}
+
+const domainType = "EIP712Domain"
+
+// standard EIP712Domain members, in the order defined by EIP-712
+var domainFields = []TypedDataField{
+	{Name: "name", Type: "string"},
+	{Name: "version", Type: "string"},
+	{Name: "chainId", Type: "uint256"},
+	{Name: "verifyingContract", Type: "address"},
+	{Name: "salt", Type: "bytes32"},
+}
+
+// HashTypedData returns the EIP-712 digest of typed data:
+// keccak256("\x19\x01" || domainSeparator || hashStruct(message))
+func HashTypedData(td *TypedData) ([32]byte, error) {
+	types := td.Types
+	if _, ok := types[domainType]; !ok {
+		// Derive the domain type from the members present
+		types = make(map[string][]TypedDataField, len(td.Types)+1)
+		for k, v := range td.Types {
+			types[k] = v
+		}
+		var fields []TypedDataField
+		for _, f := range domainFields {
+			if _, ok := td.Domain[f.Name]; ok {
+				fields = append(fields, f)
+			}
+		}
+		types[domainType] = fields
+	}
+
+	domainSeparator, err := hashStruct(types, domainType, td.Domain)
+	if err != nil {
+		return [32]byte{}, fmt.Errorf("failed to hash domain: %w", err)
+	}
+	messageHash, err := hashStruct(types, td.PrimaryType, td.Message)
+	if err != nil {
+		return [32]byte{}, fmt.Errorf("failed to hash message: %w", err)
+	}
+
+	return keccak.Sum256([]byte{0x19, 0x01}, domainSeparator[:], messageHash[:]), nil
+}
+
+// hashStruct computes keccak256(typeHash || encodeData(data))
+func hashStruct(types map[string][]TypedDataField, primary string, data map[string]interface{}) ([32]byte, error) {
+	fields, ok := types[primary]
+	if !ok {
+		return [32]byte{}, fmt.Errorf("unknown type %q", primary)
+	}
+
+	typeHash := keccak.Sum256([]byte(encodeType(types, primary)))
+	enc := append([]byte{}, typeHash[:]...)
+	for _, f := range fields {
+		v, err := encodeField(types, f.Type, data[f.Name])
+		if err != nil {
+			return [32]byte{}, fmt.Errorf("%s.%s: %w", primary, f.Name, err)
+		}
+		enc = append(enc, v...)
+	}
+	return keccak.Sum256(enc), nil
+}
+
+// encodeType returns the primary type followed by its referenced types in
+// alphabetical order, e.g. "Mail(Person from,Person to)Person(string name)"
+func encodeType(types map[string][]TypedDataField, primary string) string {
+	deps := make(map[string]bool)
+	collectDependencies(types, primary, deps)
+	delete(deps, primary)
+
+	names := make([]string, 0, len(deps))
+	for name := range deps {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	for _, name := range append([]string{primary}, names...) {
+		b.WriteString(name)
+		b.WriteString("(")
+		for i, f := range types[name] {
+			if i > 0 {
+				b.WriteString(",")
+			}
+			b.WriteString(f.Type)
+			b.WriteString(" ")
+			b.WriteString(f.Name)
+		}
+		b.WriteString(")")
+	}
+	return b.String()
+}
+
+func collectDependencies(types map[string][]TypedDataField, typ string, deps map[string]bool) {
+	typ = baseType(typ)
+	if deps[typ] {
+		return
+	}
+	if _, ok := types[typ]; !ok {
+		return
+	}
+	deps[typ] = true
+	for _, f := range types[typ] {
+		collectDependencies(types, f.Type, deps)
+	}
+}
+
+// baseType strips array suffixes: "Person[][2]" -> "Person"
+func baseType(typ string) string {
+	if i := strings.IndexByte(typ, '['); i >= 0 {
+		return typ[:i]
+	}
+	return typ
+}
+
+// encodeField encodes one member value as a 32-byte word
+func encodeField(types map[string][]TypedDataField, typ string, value interface{}) ([]byte, error) {
+	if strings.HasSuffix(typ, "]") {
+		items, ok := value.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected array for %s, got %T", typ, value)
+		}
+		elem := typ[:strings.LastIndexByte(typ, '[')]
+
+		var enc []byte
+		for _, item := range items {
+			v, err := encodeField(types, elem, item)
+			if err != nil {
+				return nil, err
+			}
+			enc = append(enc, v...)
+		}
+		hash := keccak.Sum256(enc)
+		return hash[:], nil
+	}
+
+	if _, ok := types[typ]; ok {
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected object for %s, got %T", typ, value)
+		}
+		hash, err := hashStruct(types, typ, m)
+		if err != nil {
+			return nil, err
+		}
+		return hash[:], nil
+	}
+
+	switch typ {
+	case "string":
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected string, got %T", value)
+		}
+		hash := keccak.Sum256([]byte(s))
+		return hash[:], nil
+	case "bytes":
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected hex string, got %T", value)
+		}
+		raw, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+		if err != nil {
+			return nil, fmt.Errorf("bytes value is not hex: %w", err)
+		}
+		hash := keccak.Sum256(raw)
+		return hash[:], nil
+	}
+
+	return abi.EncodeArguments([]string{typ}, []interface{}{value})
+}
@@ -0,0 +1,124 @@
+package signing
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+
+	"github.com/paratro/paratro-sdk-go/address"
+	"github.com/paratro/paratro-sdk-go/internal/keccak"
+)
+
+// secp256k1 domain parameters (SEC 2, section 2.4.1)
+var (
+	curveP, _  = new(big.Int).SetString("fffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f", 16)
+	curveN, _  = new(big.Int).SetString("fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364141", 16)
+	curveGx, _ = new(big.Int).SetString("79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798", 16)
+	curveGy, _ = new(big.Int).SetString("483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8", 16)
+	curveB     = big.NewInt(7)
+)
+
+// ErrInvalidSignature is returned for malformed or unrecoverable signatures
+var ErrInvalidSignature = errors.New("invalid signature")
+
+// point is an affine curve point; nil coordinates denote infinity
+type point struct {
+	x, y *big.Int
+}
+
+func (p point) infinity() bool {
+	return p.x == nil
+}
+
+func addPoints(a, b point) point {
+	if a.infinity() {
+		return b
+	}
+	if b.infinity() {
+		return a
+	}
+
+	var lambda *big.Int
+	if a.x.Cmp(b.x) == 0 {
+		// P + (-P) = infinity
+		if a.y.Cmp(b.y) != 0 || a.y.Sign() == 0 {
+			return point{}
+		}
+		// Doubling: (3x^2) / (2y)
+		num := new(big.Int).Mul(a.x, a.x)
+		num.Mul(num, big.NewInt(3))
+		den := new(big.Int).Lsh(a.y, 1)
+		lambda = num.Mul(num, den.ModInverse(den, curveP))
+	} else {
+		num := new(big.Int).Sub(b.y, a.y)
+		den := new(big.Int).Sub(b.x, a.x)
+		den.Mod(den, curveP)
+		lambda = num.Mul(num, den.ModInverse(den, curveP))
+	}
+	lambda.Mod(lambda, curveP)
+
+	x := new(big.Int).Mul(lambda, lambda)
+	x.Sub(x, a.x).Sub(x, b.x).Mod(x, curveP)
+
+	y := new(big.Int).Sub(a.x, x)
+	y.Mul(y, lambda).Sub(y, a.y).Mod(y, curveP)
+
+	return point{x: x, y: y}
+}
+
+func scalarMult(p point, k *big.Int) point {
+	result := point{}
+	for i := k.BitLen() - 1; i >= 0; i-- {
+		result = addPoints(result, result)
+		if k.Bit(i) == 1 {
+			result = addPoints(result, p)
+		}
+	}
+	return result
+}
+
+// recoverPublicKey recovers the public key that produced a signature over
+// digest. recID is the recovery id (0 or 1).
+func recoverPublicKey(digest []byte, r, s *big.Int, recID byte) (point, error) {
+	if r.Sign() <= 0 || r.Cmp(curveN) >= 0 || s.Sign() <= 0 || s.Cmp(curveN) >= 0 || recID > 1 {
+		return point{}, ErrInvalidSignature
+	}
+
+	// R = (r, y) where y has the parity given by recID
+	ySquared := new(big.Int).Exp(r, big.NewInt(3), curveP)
+	ySquared.Add(ySquared, curveB).Mod(ySquared, curveP)
+	exp := new(big.Int).Add(curveP, big.NewInt(1))
+	exp.Rsh(exp, 2)
+	y := new(big.Int).Exp(ySquared, exp, curveP)
+	if new(big.Int).Exp(y, big.NewInt(2), curveP).Cmp(ySquared) != 0 {
+		return point{}, ErrInvalidSignature
+	}
+	if y.Bit(0) != uint(recID) {
+		y.Sub(curveP, y)
+	}
+	R := point{x: new(big.Int).Set(r), y: y}
+
+	// Q = r^-1 (sR - eG)
+	e := new(big.Int).SetBytes(digest)
+	e.Mod(e, curveN)
+	negE := new(big.Int).Sub(curveN, e)
+	negE.Mod(negE, curveN)
+
+	sum := addPoints(scalarMult(R, s), scalarMult(point{x: curveGx, y: curveGy}, negE))
+	q := scalarMult(sum, new(big.Int).ModInverse(r, curveN))
+	if q.infinity() {
+		return point{}, ErrInvalidSignature
+	}
+	return q, nil
+}
+
+// publicKeyAddress returns the EIP-55 Ethereum address of a public key
+func publicKeyAddress(q point) string {
+	buf := make([]byte, 64)
+	q.x.FillBytes(buf[:32])
+	q.y.FillBytes(buf[32:])
+	hash := keccak.Sum256(buf)
+
+	addr, _ := address.ToChecksumAddress("0x" + hex.EncodeToString(hash[12:]))
+	return addr
+}
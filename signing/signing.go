@@ -0,0 +1,209 @@
+// Package signing requests MPC signatures over messages, EIP-712 typed data
+// and raw digests, and verifies them locally by recovering the signer.
+package signing
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/paratro/paratro-sdk-go/address"
+	"github.com/paratro/paratro-sdk-go/common"
+	"github.com/paratro/paratro-sdk-go/internal/keccak"
+)
+
+// Sign types
+const (
+	SignTypePersonal  = "PERSONAL_SIGN"
+	SignTypeTypedData = "EIP712"
+	SignTypeRawDigest = "RAW_DIGEST"
+)
+
+// ErrSignerMismatch is returned when a signature was not produced by the expected address
+var ErrSignerMismatch = errors.New("signature signer mismatch")
+
+// Service handles signing-related API operations
+type Service struct {
+	client *common.Client
+}
+
+// NewService creates a new signing service
+func NewService(client *common.Client) *Service {
+	return &Service{client: client}
+}
+
+// Signature represents an MPC signature produced for an account
+type Signature struct {
+	SignatureID string      `json:"signature_id"`
+	AccountID   string      `json:"account_id"`
+	Address     string      `json:"address"`
+	SignType    string      `json:"sign_type"` // PERSONAL_SIGN, EIP712, RAW_DIGEST
+	Digest      string      `json:"digest"`    // 0x-prefixed 32-byte hash that was signed
+	Signature   string      `json:"signature"` // 0x-prefixed r || s || v (65 bytes)
+	CreatedAt   common.Time `json:"created_at"`
+}
+
+// Verify recovers the signer of the signature and checks it against an
+// address, typically account.Account.Address
+func (s *Signature) Verify(expectedAddress string) error {
+	digest, err := decodeHex(s.Digest)
+	if err != nil {
+		return fmt.Errorf("%w: digest is not hex", ErrInvalidSignature)
+	}
+	sig, err := decodeHex(s.Signature)
+	if err != nil {
+		return fmt.Errorf("%w: signature is not hex", ErrInvalidSignature)
+	}
+	return VerifyDigest(expectedAddress, digest, sig)
+}
+
+type signRequest struct {
+	AccountID string     `json:"account_id"`
+	SignType  string     `json:"sign_type"`
+	Message   string     `json:"message,omitempty"`
+	TypedData *TypedData `json:"typed_data,omitempty"`
+	Digest    string     `json:"digest"`
+}
+
+// SignMessage signs a message with the personal_sign (EIP-191) prefix
+func (s *Service) SignMessage(ctx context.Context, accountID string, message []byte) (*Signature, error) {
+	digest := HashMessage(message)
+	return s.sign(ctx, &signRequest{
+		AccountID: accountID,
+		SignType:  SignTypePersonal,
+		Message:   "0x" + hex.EncodeToString(message),
+		Digest:    "0x" + hex.EncodeToString(digest[:]),
+	})
+}
+
+// SignTypedData signs EIP-712 typed data
+func (s *Service) SignTypedData(ctx context.Context, accountID string, typedData *TypedData) (*Signature, error) {
+	digest, err := HashTypedData(typedData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign typed data: %w", err)
+	}
+	return s.sign(ctx, &signRequest{
+		AccountID: accountID,
+		SignType:  SignTypeTypedData,
+		TypedData: typedData,
+		Digest:    "0x" + hex.EncodeToString(digest[:]),
+	})
+}
+
+// SignDigest signs a raw 32-byte digest. Only use this for hashes you have
+// computed yourself; a raw digest can authorize anything.
+func (s *Service) SignDigest(ctx context.Context, accountID string, digest [32]byte) (*Signature, error) {
+	return s.sign(ctx, &signRequest{
+		AccountID: accountID,
+		SignType:  SignTypeRawDigest,
+		Digest:    "0x" + hex.EncodeToString(digest[:]),
+	})
+}
+
+// sign submits a signing request and checks the result against the locally
+// computed digest before returning it
+func (s *Service) sign(ctx context.Context, req *signRequest) (*Signature, error) {
+	var signature Signature
+	err := s.client.Request("POST", "/api/v1/signatures", req, &signature)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign: %w", err)
+	}
+
+	if !strings.EqualFold(signature.Digest, req.Digest) {
+		return nil, fmt.Errorf("failed to sign: server signed digest %s, expected %s", signature.Digest, req.Digest)
+	}
+	if signature.Address != "" {
+		if err := signature.Verify(signature.Address); err != nil {
+			return nil, fmt.Errorf("failed to sign: %w", err)
+		}
+	}
+	return &signature, nil
+}
+
+// ============ Verification ============
+
+// HashMessage returns the EIP-191 personal_sign digest of a message:
+// keccak256("\x19Ethereum Signed Message:\n" || len(message) || message)
+func HashMessage(message []byte) [32]byte {
+	prefix := "\x19Ethereum Signed Message:\n" + strconv.Itoa(len(message))
+	return keccak.Sum256([]byte(prefix), message)
+}
+
+// RecoverAddress returns the EIP-55 address that produced a 65-byte
+// r || s || v signature over a digest. v may be 0/1 or 27/28.
+func RecoverAddress(digest, sig []byte) (string, error) {
+	if len(digest) != 32 {
+		return "", fmt.Errorf("%w: digest must be 32 bytes", ErrInvalidSignature)
+	}
+	if len(sig) != 65 {
+		return "", fmt.Errorf("%w: signature must be 65 bytes, got %d", ErrInvalidSignature, len(sig))
+	}
+
+	v := sig[64]
+	if v >= 27 {
+		v -= 27
+	}
+	r := new(big.Int).SetBytes(sig[:32])
+	s := new(big.Int).SetBytes(sig[32:64])
+
+	q, err := recoverPublicKey(digest, r, s, v)
+	if err != nil {
+		return "", err
+	}
+	return publicKeyAddress(q), nil
+}
+
+// VerifyDigest checks that sig over digest was produced by expectedAddress.
+// EVM hex addresses and Tron base58 addresses are both accepted.
+func VerifyDigest(expectedAddress string, digest, sig []byte) error {
+	recovered, err := RecoverAddress(digest, sig)
+	if err != nil {
+		return err
+	}
+
+	expected, err := signerBytes(expectedAddress)
+	if err != nil {
+		return err
+	}
+	got, _ := decodeHex(recovered)
+	if !bytes.Equal(expected, got) {
+		return fmt.Errorf("%w: signed by %s, expected %s", ErrSignerMismatch, recovered, expectedAddress)
+	}
+	return nil
+}
+
+// VerifyMessage checks a personal_sign signature over a message
+func VerifyMessage(expectedAddress string, message, sig []byte) error {
+	digest := HashMessage(message)
+	return VerifyDigest(expectedAddress, digest[:], sig)
+}
+
+// VerifyTypedData checks an EIP-712 signature over typed data
+func VerifyTypedData(expectedAddress string, typedData *TypedData, sig []byte) error {
+	digest, err := HashTypedData(typedData)
+	if err != nil {
+		return err
+	}
+	return VerifyDigest(expectedAddress, digest[:], sig)
+}
+
+// signerBytes returns the 20-byte key hash behind an EVM or Tron address
+func signerBytes(addr string) ([]byte, error) {
+	if strings.HasPrefix(addr, "0x") || strings.HasPrefix(addr, "0X") {
+		normalized, err := address.ToChecksumAddress(addr)
+		if err != nil {
+			return nil, err
+		}
+		return decodeHex(normalized)
+	}
+	return address.TronBytes(addr)
+}
+
+func decodeHex(s string) ([]byte, error) {
+	return hex.DecodeString(strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X"))
+}
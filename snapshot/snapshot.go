@@ -0,0 +1,135 @@
+// Package snapshot periodically records asset balances into a pluggable
+// store so that historical balances can be reported without API support.
+package snapshot
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/paratro/paratro-sdk-go/asset"
+	"github.com/paratro/paratro-sdk-go/common"
+)
+
+// ErrNoSnapshot is returned when no snapshot exists for a query
+var ErrNoSnapshot = errors.New("no balance snapshot")
+
+// Store persists balance snapshots
+type Store interface {
+	// Save appends snapshots to the store
+	Save(ctx context.Context, snapshots []*asset.BalanceSnapshot) error
+
+	// BalanceAt returns the latest snapshot of an asset taken at or before at
+	BalanceAt(ctx context.Context, assetID string, at time.Time) (*asset.BalanceSnapshot, error)
+
+	// Range returns the snapshots of an asset taken in [from, to], oldest first
+	Range(ctx context.Context, assetID string, from, to time.Time) ([]*asset.BalanceSnapshot, error)
+}
+
+// Snapshotter records the balances of all assets into a Store
+type Snapshotter struct {
+	assets *asset.Service
+	store  Store
+
+	// WalletID restricts snapshots to one wallet; empty means all wallets
+	WalletID string
+
+	// OnError receives errors from Run; it may be nil
+	OnError func(error)
+}
+
+// NewSnapshotter creates a snapshotter that reads balances from the asset service
+func NewSnapshotter(assets *asset.Service, store Store) *Snapshotter {
+	return &Snapshotter{
+		assets: assets,
+		store:  store,
+	}
+}
+
+// SnapshotOnce records the current balance of every asset and returns the
+// number of snapshots written
+func (s *Snapshotter) SnapshotOnce(ctx context.Context) (int, error) {
+	assets, err := s.assets.ListAll(ctx, &asset.ListAssetsRequest{WalletID: s.WalletID})
+	if err != nil {
+		return 0, err
+	}
+
+	now := common.NewTime(time.Now().UTC())
+	snapshots := make([]*asset.BalanceSnapshot, 0, len(assets))
+	for _, a := range assets {
+		snapshots = append(snapshots, &asset.BalanceSnapshot{
+			AssetID:   a.AssetID,
+			AccountID: a.AccountID,
+			WalletID:  a.WalletID,
+			Symbol:    a.Symbol,
+			Balance:   a.Balance,
+			Timestamp: now,
+		})
+	}
+
+	if err := s.store.Save(ctx, snapshots); err != nil {
+		return 0, err
+	}
+	return len(snapshots), nil
+}
+
+// Run takes a snapshot every interval until the context is cancelled
+func (s *Snapshotter) Run(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.SnapshotOnce(ctx); err != nil && s.OnError != nil && ctx.Err() == nil {
+			s.OnError(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// selectAt returns the latest snapshot of an asset at or before at
+func selectAt(snapshots []*asset.BalanceSnapshot, assetID string, at time.Time) (*asset.BalanceSnapshot, error) {
+	var best *asset.BalanceSnapshot
+	for _, snap := range snapshots {
+		if snap.AssetID != assetID {
+			continue
+		}
+		t := snap.Timestamp.Time
+		if t.IsZero() || t.After(at) {
+			continue
+		}
+		if best == nil || !t.Before(best.Timestamp.Time) {
+			best = snap
+		}
+	}
+
+	if best == nil {
+		return nil, ErrNoSnapshot
+	}
+	return best, nil
+}
+
+// selectRange returns the snapshots of an asset in [from, to], oldest first
+func selectRange(snapshots []*asset.BalanceSnapshot, assetID string, from, to time.Time) []*asset.BalanceSnapshot {
+	var out []*asset.BalanceSnapshot
+	for _, snap := range snapshots {
+		if snap.AssetID != assetID {
+			continue
+		}
+		t := snap.Timestamp.Time
+		if t.IsZero() || t.Before(from) || t.After(to) {
+			continue
+		}
+		out = append(out, snap)
+	}
+
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].Timestamp.Before(out[j].Timestamp.Time)
+	})
+	return out
+}
@@ -0,0 +1,133 @@
+package snapshot
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/paratro/paratro-sdk-go/asset"
+)
+
+// MemoryStore keeps snapshots in memory
+type MemoryStore struct {
+	mu        sync.RWMutex
+	snapshots []*asset.BalanceSnapshot
+}
+
+// NewMemoryStore creates an empty in-memory store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{}
+}
+
+// Save appends snapshots to the store
+func (m *MemoryStore) Save(ctx context.Context, snapshots []*asset.BalanceSnapshot) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.snapshots = append(m.snapshots, snapshots...)
+	return nil
+}
+
+// BalanceAt returns the latest snapshot of an asset taken at or before at
+func (m *MemoryStore) BalanceAt(ctx context.Context, assetID string, at time.Time) (*asset.BalanceSnapshot, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return selectAt(m.snapshots, assetID, at)
+}
+
+// Range returns the snapshots of an asset taken in [from, to], oldest first
+func (m *MemoryStore) Range(ctx context.Context, assetID string, from, to time.Time) ([]*asset.BalanceSnapshot, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return selectRange(m.snapshots, assetID, from, to), nil
+}
+
+// JSONLinesStore appends snapshots to a file, one JSON object per line
+type JSONLinesStore struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewJSONLinesStore creates a store backed by the file at path. The file is
+// created on the first Save.
+func NewJSONLinesStore(path string) *JSONLinesStore {
+	return &JSONLinesStore{path: path}
+}
+
+// Save appends snapshots to the file
+func (j *JSONLinesStore) Save(ctx context.Context, snapshots []*asset.BalanceSnapshot) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	f, err := os.OpenFile(j.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to open snapshot file: %w", err)
+	}
+	defer f.Close()
+
+	w := bufio.NewWriter(f)
+	enc := json.NewEncoder(w)
+	for _, snap := range snapshots {
+		if err := enc.Encode(snap); err != nil {
+			return fmt.Errorf("failed to write snapshot: %w", err)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+	return nil
+}
+
+// BalanceAt returns the latest snapshot of an asset taken at or before at
+func (j *JSONLinesStore) BalanceAt(ctx context.Context, assetID string, at time.Time) (*asset.BalanceSnapshot, error) {
+	snapshots, err := j.load(assetID)
+	if err != nil {
+		return nil, err
+	}
+	return selectAt(snapshots, assetID, at)
+}
+
+// Range returns the snapshots of an asset taken in [from, to], oldest first
+func (j *JSONLinesStore) Range(ctx context.Context, assetID string, from, to time.Time) ([]*asset.BalanceSnapshot, error) {
+	snapshots, err := j.load(assetID)
+	if err != nil {
+		return nil, err
+	}
+	return selectRange(snapshots, assetID, from, to), nil
+}
+
+// load reads the snapshots of one asset from the file
+func (j *JSONLinesStore) load(assetID string) ([]*asset.BalanceSnapshot, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	f, err := os.Open(j.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open snapshot file: %w", err)
+	}
+	defer f.Close()
+
+	var snapshots []*asset.BalanceSnapshot
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var snap asset.BalanceSnapshot
+		if err := json.Unmarshal(scanner.Bytes(), &snap); err != nil {
+			return nil, fmt.Errorf("failed to decode snapshot: %w", err)
+		}
+		if snap.AssetID == assetID {
+			snapshots = append(snapshots, &snap)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read snapshot file: %w", err)
+	}
+	return snapshots, nil
+}
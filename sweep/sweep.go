@@ -0,0 +1,294 @@
+// Package sweep collects balances from a wallet's deposit accounts into a
+// target account. Token sweeps are preceded by a native top-up when the
+// deposit account cannot pay for gas.
+package sweep
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/paratro/paratro-sdk-go/account"
+	"github.com/paratro/paratro-sdk-go/address"
+	"github.com/paratro/paratro-sdk-go/amount"
+	"github.com/paratro/paratro-sdk-go/asset"
+	"github.com/paratro/paratro-sdk-go/common"
+	"github.com/paratro/paratro-sdk-go/transaction"
+)
+
+// Sweeper plans and executes sweeps for one wallet
+type Sweeper struct {
+	accounts     *account.Service
+	assets       *asset.Service
+	transactions *transaction.Service
+
+	WalletID string
+
+	// TargetAccountID receives swept funds and pays gas top-ups. Only
+	// accounts on its chain and network are swept, so Thresholds and
+	// GasPerTransfer are in terms of that chain.
+	TargetAccountID string
+
+	// Thresholds maps token contract addresses, and native asset symbols
+	// such as "ETH", to the minimum balance worth sweeping. Tokens are only
+	// matched by contract since symbols like "USDT" are not unique. Assets
+	// without a threshold are never swept.
+	Thresholds map[string]amount.Amount
+
+	// GasPerTransfer is the native amount one transfer costs. Native sweeps
+	// leave it behind, and token sweeps top the account up to it.
+	GasPerTransfer amount.Amount
+
+	// GasWaitInterval is how often top-ups are polled for confirmation
+	// before token sweeps are sent; zero means 10 seconds
+	GasWaitInterval time.Duration
+}
+
+// NewSweeper creates a sweeper using the given services
+func NewSweeper(accounts *account.Service, assets *asset.Service, transactions *transaction.Service) *Sweeper {
+	return &Sweeper{
+		accounts:     accounts,
+		assets:       assets,
+		transactions: transactions,
+	}
+}
+
+// Item is one planned sweep
+type Item struct {
+	Asset   *asset.Asset
+	Account *account.Account // Source deposit account
+	Amount  amount.Amount
+
+	// GasTopUp is the native amount to send to the account first; nil when
+	// the account already holds enough gas
+	GasTopUp *amount.Amount
+}
+
+// Plan is a dry run of a sweep
+type Plan struct {
+	ID       string // Prefix of the idempotency keys, so a plan never executes twice
+	Target   *account.Account
+	GasAsset *asset.Asset // Target's native asset, which pays gas top-ups
+	Items    []*Item
+}
+
+// Result is the outcome of one planned sweep
+type Result struct {
+	Item           *Item
+	GasTransaction *transaction.Transaction
+	Transaction    *transaction.Transaction
+	Err            error
+}
+
+// Report is the outcome of executing a plan
+type Report struct {
+	PlanID  string
+	Results []*Result
+}
+
+// Failed returns the results that did not submit a sweep transfer
+func (r *Report) Failed() []*Result {
+	var failed []*Result
+	for _, res := range r.Results {
+		if res.Err != nil {
+			failed = append(failed, res)
+		}
+	}
+	return failed
+}
+
+// Plan scans the wallet's assets and returns the sweeps that would run,
+// without submitting anything
+func (s *Sweeper) Plan(ctx context.Context) (*Plan, error) {
+	target, err := s.accounts.Get(ctx, s.TargetAccountID)
+	if err != nil {
+		return nil, err
+	}
+	accounts, err := s.accounts.ListAll(ctx, &account.ListAccountsRequest{WalletID: s.WalletID})
+	if err != nil {
+		return nil, err
+	}
+	assets, err := s.assets.ListAll(ctx, &asset.ListAssetsRequest{WalletID: s.WalletID})
+	if err != nil {
+		return nil, err
+	}
+
+	// The random suffix keeps plans made in the same second apart
+	suffix := make([]byte, 4)
+	if _, err := rand.Read(suffix); err != nil {
+		return nil, fmt.Errorf("failed to generate plan ID: %w", err)
+	}
+	plan := &Plan{
+		ID:     fmt.Sprintf("sweep-%s-%d-%s", s.WalletID, time.Now().Unix(), hex.EncodeToString(suffix)),
+		Target: target,
+	}
+
+	natives := make(map[string]*asset.Asset)
+	tokens := make(map[string][]*asset.Asset)
+	for _, a := range assets {
+		if a.Status != "" && a.Status != asset.StatusActive {
+			continue
+		}
+		if a.AssetType == asset.AssetTypeNative {
+			natives[a.AccountID] = a
+		} else {
+			tokens[a.AccountID] = append(tokens[a.AccountID], a)
+		}
+	}
+	plan.GasAsset = natives[target.AccountID]
+
+	for i := range accounts {
+		acct := &accounts[i]
+		if acct.AccountID == target.AccountID || acct.Status != account.StatusActive {
+			continue
+		}
+		// Gas, thresholds and the target address only apply on the target's chain
+		if address.CanonicalChain(string(acct.Chain)) != address.CanonicalChain(string(target.Chain)) ||
+			!strings.EqualFold(string(acct.Network), string(target.Network)) {
+			continue
+		}
+
+		gas := amount.Zero(0)
+		native := natives[acct.AccountID]
+		if native != nil {
+			gas = native.Balance
+		}
+
+		sweeps := 0
+		for _, token := range tokens[acct.AccountID] {
+			if !s.worthSweeping(target.Chain, token) {
+				continue
+			}
+			item := &Item{Asset: token, Account: acct, Amount: token.Balance}
+			if gas.Cmp(s.GasPerTransfer) < 0 {
+				topUp := s.GasPerTransfer.Sub(gas)
+				item.GasTopUp = &topUp
+				gas = s.GasPerTransfer
+			}
+			gas = gas.Sub(s.GasPerTransfer)
+			plan.Items = append(plan.Items, item)
+			sweeps++
+		}
+
+		// Native funds are only swept when no token sweep needs them for gas
+		if native != nil && s.worthSweeping(target.Chain, native) && sweeps == 0 {
+			sweepable := native.Balance.Sub(s.GasPerTransfer)
+			if sweepable.Sign() > 0 {
+				plan.Items = append(plan.Items, &Item{Asset: native, Account: acct, Amount: sweepable})
+			}
+		}
+	}
+	return plan, nil
+}
+
+// Execute submits a plan: gas top-ups first, then, once each account's
+// top-up has confirmed, the sweep transfers to the target account
+func (s *Sweeper) Execute(ctx context.Context, plan *Plan) (*Report, error) {
+	report := &Report{PlanID: plan.ID}
+
+	for _, item := range plan.Items {
+		res := &Result{Item: item}
+		report.Results = append(report.Results, res)
+		if item.GasTopUp == nil {
+			continue
+		}
+		if plan.GasAsset == nil {
+			res.Err = fmt.Errorf("target account %s has no native asset to pay gas", plan.Target.AccountID)
+			continue
+		}
+		res.GasTransaction, res.Err = s.transactions.Transfer(ctx, &transaction.CreateTransferRequest{
+			AccountID:      plan.Target.AccountID,
+			AssetID:        plan.GasAsset.AssetID,
+			ToAddress:      item.Account.Address,
+			Amount:         *item.GasTopUp,
+			Chain:          item.Account.Chain,
+			Network:        item.Account.Network,
+			IdempotencyKey: plan.ID + "-gas-" + item.Asset.AssetID,
+		})
+	}
+
+	for _, res := range report.Results {
+		if res.Err != nil {
+			continue
+		}
+		if res.GasTransaction != nil {
+			if err := s.waitConfirmed(ctx, res.GasTransaction.TxID); err != nil {
+				res.Err = fmt.Errorf("gas top-up %s: %w", res.GasTransaction.TxID, err)
+				continue
+			}
+		}
+		res.Transaction, res.Err = s.transactions.Transfer(ctx, &transaction.CreateTransferRequest{
+			AccountID:      res.Item.Account.AccountID,
+			AssetID:        res.Item.Asset.AssetID,
+			ToAddress:      plan.Target.Address,
+			Amount:         res.Item.Amount,
+			Chain:          plan.Target.Chain,
+			Network:        plan.Target.Network,
+			IdempotencyKey: plan.ID + "-" + res.Item.Asset.AssetID,
+		})
+		if ctx.Err() != nil {
+			return report, ctx.Err()
+		}
+	}
+	return report, nil
+}
+
+// Run plans and executes a sweep
+func (s *Sweeper) Run(ctx context.Context) (*Report, error) {
+	plan, err := s.Plan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.Execute(ctx, plan)
+}
+
+func (s *Sweeper) worthSweeping(chain common.Chain, a *asset.Asset) bool {
+	threshold, ok := s.threshold(chain, a)
+	return ok && a.Balance.Sign() > 0 && a.Balance.Cmp(threshold) >= 0
+}
+
+// threshold looks up a token's threshold by contract and a native asset's by symbol
+func (s *Sweeper) threshold(chain common.Chain, a *asset.Asset) (amount.Amount, bool) {
+	for key, threshold := range s.Thresholds {
+		if a.ContractAddress == "" {
+			if strings.EqualFold(key, a.Symbol) {
+				return threshold, true
+			}
+		} else if asset.NormalizeContract(chain, key) == asset.NormalizeContract(chain, a.ContractAddress) {
+			return threshold, true
+		}
+	}
+	return amount.Amount{}, false
+}
+
+// waitConfirmed polls a transaction until it confirms, fails or the context ends
+func (s *Sweeper) waitConfirmed(ctx context.Context, txID string) error {
+	interval := s.GasWaitInterval
+	if interval <= 0 {
+		interval = 10 * time.Second
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		tx, err := s.transactions.Get(ctx, txID)
+		if err != nil {
+			return err
+		}
+		switch tx.Status {
+		case transaction.StatusConfirmed:
+			return nil
+		case transaction.StatusFailed, transaction.StatusRejected, transaction.StatusDropped, transaction.StatusReplaced:
+			return fmt.Errorf("transaction is %s", tx.Status)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
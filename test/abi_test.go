@@ -0,0 +1,88 @@
+package test
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/paratro/paratro-sdk-go/abi"
+)
+
+func TestABIEncodeCall(t *testing.T) {
+	tests := []struct {
+		signature string
+		args      []interface{}
+		want      string
+	}{
+		{
+			// Examples from the Solidity ABI specification
+			"baz(uint32,bool)",
+			[]interface{}{69, true},
+			"cdcd77c0" +
+				"0000000000000000000000000000000000000000000000000000000000000045" +
+				"0000000000000000000000000000000000000000000000000000000000000001",
+		},
+		{
+			"sam(bytes,bool,uint[])",
+			[]interface{}{[]byte("dave"), true, []int{1, 2, 3}},
+			"a5643bf2" +
+				"0000000000000000000000000000000000000000000000000000000000000060" +
+				"0000000000000000000000000000000000000000000000000000000000000001" +
+				"00000000000000000000000000000000000000000000000000000000000000a0" +
+				"0000000000000000000000000000000000000000000000000000000000000004" +
+				"6461766500000000000000000000000000000000000000000000000000000000" +
+				"0000000000000000000000000000000000000000000000000000000000000003" +
+				"0000000000000000000000000000000000000000000000000000000000000001" +
+				"0000000000000000000000000000000000000000000000000000000000000002" +
+				"0000000000000000000000000000000000000000000000000000000000000003",
+		},
+		{
+			abi.SigApprove,
+			[]interface{}{"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", big.NewInt(1000000)},
+			"095ea7b3" +
+				"0000000000000000000000005aaeb6053f3e94c9b9a09f33669435e7ef1beaed" +
+				"00000000000000000000000000000000000000000000000000000000000f4240",
+		},
+		{
+			abi.SigTransfer,
+			[]interface{}{"TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t", "1"},
+			"a9059cbb" +
+				"000000000000000000000000a614f803b6fd780986a42c78ec9c7f77e6ded13c" +
+				"0000000000000000000000000000000000000000000000000000000000000001",
+		},
+	}
+
+	for _, tt := range tests {
+		data, err := abi.EncodeCall(tt.signature, tt.args...)
+		if err != nil {
+			t.Fatalf("EncodeCall(%s): %v", tt.signature, err)
+		}
+		if got := hex.EncodeToString(data); got != tt.want {
+			t.Errorf("EncodeCall(%s):\n got %s\nwant %s", tt.signature, got, tt.want)
+		}
+	}
+}
+
+func TestABIEncodeNegativeInt(t *testing.T) {
+	data, err := abi.EncodeCall("f(int8)", -1)
+	if err != nil {
+		t.Fatalf("EncodeCall: %v", err)
+	}
+	if got := hex.EncodeToString(data[4:]); got != strings.Repeat("ff", 32) {
+		t.Errorf("Expected two's complement -1, got %s", got)
+	}
+}
+
+func TestABIEncodeErrors(t *testing.T) {
+	if _, err := abi.EncodeCall("f(uint8)", 256); !errors.Is(err, abi.ErrInvalidArgument) {
+		t.Errorf("Expected out of range error, got %v", err)
+	}
+	if _, err := abi.EncodeCall("f((uint256,bool))", nil); !errors.Is(err, abi.ErrInvalidSignature) {
+		t.Errorf("Expected unsupported tuple error, got %v", err)
+	}
+	if _, err := abi.EncodeCall(abi.SigTransfer, "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD", 1); !errors.Is(err, abi.ErrInvalidArgument) {
+		t.Errorf("Expected bad checksum error, got %v", err)
+	}
+}
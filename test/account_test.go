@@ -0,0 +1,280 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/paratro/paratro-sdk-go/account"
+)
+
+// fakeAccounts creates and lists the accounts of one wallet, tracking how
+// many create requests are in flight
+type fakeAccounts struct {
+	mu          sync.Mutex
+	accounts    []account.Account
+	failLabels  map[string]bool
+	inFlight    int
+	maxInFlight int
+}
+
+func newFakeAccounts(api *fakeAPI, failLabels ...string) *fakeAccounts {
+	f := &fakeAccounts{failLabels: make(map[string]bool)}
+	for _, label := range failLabels {
+		f.failLabels[label] = true
+	}
+
+	api.handle("/api/v1/accounts", func(caller string, r *http.Request) (interface{}, string) {
+		if r.Method == http.MethodGet {
+			f.mu.Lock()
+			defer f.mu.Unlock()
+			return &account.ListAccountsResponse{Items: f.accounts}, ""
+		}
+
+		var req account.CreateAccountRequest
+		json.NewDecoder(r.Body).Decode(&req)
+
+		f.mu.Lock()
+		f.inFlight++
+		if f.inFlight > f.maxInFlight {
+			f.maxInFlight = f.inFlight
+		}
+		f.mu.Unlock()
+
+		time.Sleep(10 * time.Millisecond)
+
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		f.inFlight--
+		if f.failLabels[req.Label] {
+			return nil, "address derivation failed"
+		}
+		// The server's address index deliberately differs from the label index
+		a := account.Account{
+			AccountID:    fmt.Sprintf("account-%d", len(f.accounts)),
+			WalletID:     req.WalletID,
+			Chain:        req.Chain,
+			Network:      req.Network,
+			Label:        req.Label,
+			AddressIndex: 100 + len(f.accounts),
+			Status:       account.StatusActive,
+		}
+		f.accounts = append(f.accounts, a)
+		return &a, ""
+	})
+	return f
+}
+
+func TestAccountBatchCreateAndResume(t *testing.T) {
+	api := newFakeAPI(t)
+	accounts := newFakeAccounts(api, "deposit-3")
+	client := api.client(t, "key")
+	ctx := context.Background()
+
+	req := &account.BatchCreateAccountsRequest{
+		WalletID:      "wallet-1",
+		Chain:         "ETH",
+		Network:       "mainnet",
+		LabelTemplate: "deposit-{index}",
+		Count:         6,
+		Concurrency:   2,
+	}
+	result, err := client.Account.BatchCreate(ctx, req)
+	if err != nil {
+		t.Fatalf("BatchCreate: %v", err)
+	}
+	if result.Succeeded != 5 || result.Failed != 1 {
+		t.Errorf("Expected 5 created and 1 failed, got %d and %d", result.Succeeded, result.Failed)
+	}
+	failed := result.FailedItems()
+	if len(failed) != 1 || failed[0].Index != 3 || failed[0].Label != "deposit-3" || failed[0].Err == nil {
+		t.Errorf("Expected deposit-3 to fail, got %+v", failed)
+	}
+	if result.NextIndex != 6 {
+		t.Errorf("Expected next index 6, got %d", result.NextIndex)
+	}
+	if accounts.maxInFlight != 2 {
+		t.Errorf("Expected at most 2 concurrent creates, got %d", accounts.maxInFlight)
+	}
+
+	req.Count, req.Resume = 2, true
+	result, err = client.Account.BatchCreate(ctx, req)
+	if err != nil {
+		t.Fatalf("BatchCreate with resume: %v", err)
+	}
+	if result.Items[0].Label != "deposit-6" || result.Items[1].Label != "deposit-7" || result.NextIndex != 8 {
+		t.Errorf("Expected resume to create deposit-6 and deposit-7, got %s, %s (next %d)",
+			result.Items[0].Label, result.Items[1].Label, result.NextIndex)
+	}
+
+	req.LabelTemplate = "deposit"
+	if _, err := client.Account.BatchCreate(ctx, req); err == nil {
+		t.Error("Expected resume without an index placeholder to be rejected")
+	}
+}
+
+func TestAccountBatchCreateThrottles(t *testing.T) {
+	api := newFakeAPI(t)
+	newFakeAccounts(api)
+	client := api.client(t, "key")
+
+	started := time.Now()
+	result, err := client.Account.BatchCreate(context.Background(), &account.BatchCreateAccountsRequest{
+		WalletID:          "wallet-1",
+		Chain:             "ETH",
+		Network:           "mainnet",
+		LabelTemplate:     "deposit-{index}",
+		Count:             3,
+		Concurrency:       3,
+		RequestsPerSecond: 20,
+	})
+	if err != nil {
+		t.Fatalf("BatchCreate: %v", err)
+	}
+	if result.Succeeded != 3 {
+		t.Errorf("Expected 3 accounts, got %d", result.Succeeded)
+	}
+	// One request per 50ms tick
+	if elapsed := time.Since(started); elapsed < 140*time.Millisecond {
+		t.Errorf("Expected 3 requests at 20/s to take at least 150ms, took %s", elapsed)
+	}
+}
+
+func TestAccountLifecycleCalls(t *testing.T) {
+	api := newFakeAPI(t)
+	var calls []string
+	api.handle("/api/v1/accounts/", func(caller string, r *http.Request) (interface{}, string) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		a := &account.Account{AccountID: "account-1", Label: "deposit-1", Status: account.StatusActive}
+		switch r.Method + " " + r.URL.Path {
+		case "PATCH /api/v1/accounts/account-1":
+			var req account.UpdateAccountRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			a.Label, a.Metadata = req.Label, req.Metadata
+		case "POST /api/v1/accounts/account-1/freeze":
+			a.Status = account.StatusFrozen
+		case "POST /api/v1/accounts/account-1/unfreeze":
+		case "DELETE /api/v1/accounts/account-1":
+			a.Status = account.StatusDeleted
+		default:
+			return nil, "account not found"
+		}
+		return a, ""
+	})
+	client := api.client(t, "key")
+	ctx := context.Background()
+
+	updated, err := client.Account.Update(ctx, "account-1", &account.UpdateAccountRequest{
+		Label:    "treasury",
+		Metadata: map[string]string{"tier": "gold"},
+	})
+	if err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	if updated.Label != "treasury" || updated.Metadata["tier"] != "gold" {
+		t.Errorf("Expected label treasury with tier gold, got %q with %v", updated.Label, updated.Metadata)
+	}
+
+	if _, err := client.Account.Relabel(ctx, "account-1", ""); err == nil {
+		t.Error("Expected an empty label to be rejected")
+	}
+
+	steps := []struct {
+		name string
+		call func(context.Context, string) (*account.Account, error)
+		want account.Status
+	}{
+		{"Freeze", client.Account.Freeze, account.StatusFrozen},
+		{"Unfreeze", client.Account.Unfreeze, account.StatusActive},
+		{"Delete", client.Account.Delete, account.StatusDeleted},
+	}
+	for _, step := range steps {
+		a, err := step.call(ctx, "account-1")
+		if err != nil {
+			t.Fatalf("%s: %v", step.name, err)
+		}
+		if a.Status != step.want {
+			t.Errorf("%s: expected status %s, got %s", step.name, step.want, a.Status)
+		}
+	}
+
+	if _, err := client.Account.Freeze(ctx, "account-2"); err == nil {
+		t.Error("Expected freezing an unknown account to fail")
+	}
+
+	want := []string{
+		"PATCH /api/v1/accounts/account-1",
+		"POST /api/v1/accounts/account-1/freeze",
+		"POST /api/v1/accounts/account-1/unfreeze",
+		"DELETE /api/v1/accounts/account-1",
+		"POST /api/v1/accounts/account-2/freeze",
+	}
+	if len(calls) != len(want) {
+		t.Fatalf("Expected calls %v, got %v", want, calls)
+	}
+	for i := range want {
+		if calls[i] != want[i] {
+			t.Errorf("Expected call %d to be %q, got %q", i, want[i], calls[i])
+		}
+	}
+}
+
+func TestAccountListAllFetchesEveryPage(t *testing.T) {
+	api := newFakeAPI(t)
+	var pages []string
+	api.handle("/api/v1/accounts", func(caller string, r *http.Request) (interface{}, string) {
+		q := r.URL.Query()
+		pages = append(pages, q.Get("page"))
+		page, _ := strconv.Atoi(q.Get("page"))
+		size, _ := strconv.Atoi(q.Get("page_size"))
+		var items []account.Account
+		for i := (page - 1) * size; i < page*size && i < 250; i++ {
+			items = append(items, account.Account{AccountID: fmt.Sprintf("account-%d", i), WalletID: q.Get("wallet_id")})
+		}
+		return &account.ListAccountsResponse{Items: items}, ""
+	})
+	client := api.client(t, "key")
+
+	accounts, err := client.Account.ListAll(context.Background(), &account.ListAccountsRequest{WalletID: "wallet-1", Page: 7})
+	if err != nil {
+		t.Fatalf("ListAll: %v", err)
+	}
+	if len(accounts) != 250 || accounts[249].AccountID != "account-249" || accounts[0].WalletID != "wallet-1" {
+		t.Errorf("Expected 250 accounts of wallet-1, got %d", len(accounts))
+	}
+	if strings.Join(pages, ",") != "1,2,3" {
+		t.Errorf("Expected pages 1,2,3 to be requested, got %v", pages)
+	}
+}
+
+func TestIssuedAddressesReportsGapRanges(t *testing.T) {
+	api := newFakeAPI(t)
+	accounts := []account.Account{ethAccount("a", ""), ethAccount("b", ""), ethAccount("c", ""), ethAccount("d", "")}
+	for i, index := range []int{3, 0, 1 << 30, 2} {
+		accounts[i].AddressIndex = index
+	}
+	newFakeWallet(api, append(accounts,
+		account.Account{AccountID: "trx", Chain: "TRX", Network: "mainnet", AddressIndex: 7}), nil)
+	client := api.client(t, "key")
+
+	issued, err := client.Account.IssuedAddresses(context.Background(), "wallet-1", "ethereum", "MAINNET")
+	if err != nil {
+		t.Fatalf("IssuedAddresses: %v", err)
+	}
+	if len(issued.Indexes) != 4 || issued.NextIndex != 1<<30+1 {
+		t.Errorf("Expected 4 indexes up to %d, got %v (next %d)", 1<<30, issued.Indexes, issued.NextIndex)
+	}
+	want := []account.IndexRange{{From: 1, To: 1}, {From: 4, To: 1<<30 - 1}}
+	if len(issued.Gaps) != len(want) || issued.Gaps[0] != want[0] || issued.Gaps[1] != want[1] {
+		t.Fatalf("Expected gaps %v, got %v", want, issued.Gaps)
+	}
+	if n := issued.Gaps[1].Len(); n != 1<<30-4 {
+		t.Errorf("Expected the second gap to hold %d indexes, got %d", 1<<30-4, n)
+	}
+}
@@ -0,0 +1,116 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	mpcsdk "github.com/paratro/paratro-sdk-go"
+	"github.com/paratro/paratro-sdk-go/account"
+	"github.com/paratro/paratro-sdk-go/address"
+	"github.com/paratro/paratro-sdk-go/amount"
+	"github.com/paratro/paratro-sdk-go/configuration"
+	"github.com/paratro/paratro-sdk-go/transaction"
+)
+
+func TestAddressValidate(t *testing.T) {
+	tests := []struct {
+		chain   string
+		network string
+		addr    string
+		wantErr error
+	}{
+		{"ETH", "mainnet", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", nil},
+		{"ethereum", "", "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", nil},
+		{"ETH", "", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD", address.ErrInvalidChecksum},
+		{"ETH", "", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeA", address.ErrInvalidAddress},
+		{"TRX", "mainnet", "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t", nil},
+		{"TRX", "", "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6u", address.ErrInvalidChecksum},
+		{"BTC", "mainnet", "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa", nil},
+		{"BTC", "testnet", "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa", address.ErrNetworkMismatch},
+		{"BTC", "mainnet", "3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy", nil},
+		{"BTC", "mainnet", "BC1QW508D6QEJXTDG4Y5R3ZARVARY0C5XW7KV8F3T4", nil},
+		{"BTC", "testnet", "tb1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3q0sl5k7", nil},
+		{"BTC", "mainnet", "bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj0", nil},
+		{"BTC", "mainnet", "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t5", address.ErrInvalidChecksum},
+		{"DOGE", "", "DH5yaieqoZN36fDVciNyRueRGvGLR3mr7L", address.ErrUnsupportedChain},
+	}
+
+	for _, tt := range tests {
+		err := address.Validate(tt.chain, tt.network, tt.addr)
+		if tt.wantErr == nil && err != nil {
+			t.Errorf("Validate(%s, %s): unexpected error %v", tt.chain, tt.addr, err)
+		}
+		if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+			t.Errorf("Validate(%s, %s): expected %v, got %v", tt.chain, tt.addr, tt.wantErr, err)
+		}
+	}
+}
+
+func TestAddressNormalize(t *testing.T) {
+	tests := []struct {
+		chain string
+		addr  string
+		want  string
+	}{
+		{"ETH", "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"},
+		{"TRX", "41a614f803b6fd780986a42c78ec9c7f77e6ded13c", "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t"},
+		{"BTC", "BC1QW508D6QEJXTDG4Y5R3ZARVARY0C5XW7KV8F3T4", "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4"},
+	}
+
+	for _, tt := range tests {
+		got, err := address.Normalize(tt.chain, tt.addr)
+		if err != nil {
+			t.Fatalf("Normalize(%s, %s): %v", tt.chain, tt.addr, err)
+		}
+		if got != tt.want {
+			t.Errorf("Normalize(%s, %s): expected %s, got %s", tt.chain, tt.addr, tt.want, got)
+		}
+	}
+}
+
+func TestTransferRejectsInvalidDestination(t *testing.T) {
+	client, err := mpcsdk.NewClient("key", "secret", configuration.Custom("http://127.0.0.1:0"))
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	_, err = client.Transaction.Transfer(context.Background(), &transaction.CreateTransferRequest{
+		AccountID: "account_id",
+		AssetID:   "asset_id",
+		ToAddress: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD",
+		Amount:    amount.MustParse("1"),
+		Chain:     "ETH",
+	})
+	if !errors.Is(err, address.ErrInvalidChecksum) {
+		t.Errorf("Expected checksum error, got %v", err)
+	}
+}
+
+func TestAddressCacheLookup(t *testing.T) {
+	cache := account.NewAddressCache(nil)
+	cache.Put(&account.Account{
+		AccountID: "account_id",
+		Chain:     "ETH",
+		Address:   "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		Status:    account.StatusActive,
+	})
+
+	a, ok := cache.Lookup("ethereum", "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed")
+	if !ok || a.AccountID != "account_id" {
+		t.Fatalf("Expected cached account for lowercase address")
+	}
+	if _, ok := cache.Lookup("BSC", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"); ok {
+		t.Error("Expected no match on another chain")
+	}
+
+	cache.Put(&account.Account{
+		AccountID: "account_id",
+		Chain:     "ETH",
+		Address:   "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		Status:    account.StatusDeleted,
+	})
+	if cache.Len() != 0 {
+		t.Errorf("Expected deleted account to be evicted, got %d entries", cache.Len())
+	}
+}
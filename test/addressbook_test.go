@@ -0,0 +1,103 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/paratro/paratro-sdk-go/addressbook"
+	"github.com/paratro/paratro-sdk-go/amount"
+	"github.com/paratro/paratro-sdk-go/common"
+	"github.com/paratro/paratro-sdk-go/transaction"
+)
+
+func TestAddressBookTransfer(t *testing.T) {
+	api := newFakeAPI(t)
+	entries := []*addressbook.Entry{
+		{EntryID: "active", Chain: "ETH", Address: "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", ActivatesAt: mustTime("2024-01-01T00:00:00Z")},
+		{EntryID: "cooling", Chain: "ETH", Address: "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359", ActivatesAt: common.NewTime(time.Now().Add(time.Hour))},
+	}
+	api.handle("/api/v1/wallets/wallet-1/address-book", func(caller string, r *http.Request) (interface{}, string) {
+		return entries, ""
+	})
+
+	var submitted transaction.CreateTransferRequest
+	api.handle("/api/v1/transactions", func(caller string, r *http.Request) (interface{}, string) {
+		json.NewDecoder(r.Body).Decode(&submitted)
+		return &transaction.Transaction{TxID: "tx-1", Status: transaction.StatusPending}, ""
+	})
+
+	client := api.client(t, "key")
+	ctx := context.Background()
+	req := &transaction.CreateTransferRequest{
+		AccountID: "account-1",
+		AssetID:   "eth",
+		ToAddress: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		Amount:    amount.MustParse("1"),
+		Chain:     "ETH",
+	}
+
+	tx, err := client.AddressBook.Transfer(ctx, "wallet-1", req)
+	if err != nil {
+		t.Fatalf("Transfer: %v", err)
+	}
+	if tx.TxID != "tx-1" || !submitted.RequireAllowlisted {
+		t.Errorf("Expected an allowlisted transfer, got %+v", submitted)
+	}
+
+	req.ToAddress = "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359"
+	if _, err := client.AddressBook.Transfer(ctx, "wallet-1", req); !errors.Is(err, addressbook.ErrEntryNotActive) {
+		t.Errorf("Expected ErrEntryNotActive, got %v", err)
+	}
+
+	req.ToAddress = "0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB"
+	if _, err := client.AddressBook.Transfer(ctx, "wallet-1", req); !errors.Is(err, addressbook.ErrNotAllowlisted) {
+		t.Errorf("Expected ErrNotAllowlisted, got %v", err)
+	}
+}
+
+func TestAddressBookCreateValidatesAddress(t *testing.T) {
+	client := getOfflineClient(t)
+
+	_, err := client.AddressBook.Create(context.Background(), "wallet-1", &addressbook.CreateEntryRequest{
+		Chain:   "BTC",
+		Network: "mainnet",
+		Address: "tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx",
+		Label:   "exchange",
+	})
+	if err == nil {
+		t.Error("Expected a testnet address to be rejected on mainnet")
+	}
+}
+
+func TestAddressBookTransferUsesEntryMemo(t *testing.T) {
+	api := newFakeAPI(t)
+	api.handle("/api/v1/wallets/wallet-1/address-book", func(caller string, r *http.Request) (interface{}, string) {
+		return []*addressbook.Entry{
+			{EntryID: "exchange", Chain: "TRX", Address: "TBXSw8fM4jpQkGc6zZjsVABFpVN7UvXPdV", Memo: "customer-7", ActivatesAt: mustTime("2024-01-01T00:00:00Z")},
+		}, ""
+	})
+	var submitted transaction.CreateTransferRequest
+	api.handle("/api/v1/transactions", func(caller string, r *http.Request) (interface{}, string) {
+		json.NewDecoder(r.Body).Decode(&submitted)
+		return &transaction.Transaction{TxID: "tx-1"}, ""
+	})
+
+	client := api.client(t, "key")
+	_, err := client.AddressBook.Transfer(context.Background(), "wallet-1", &transaction.CreateTransferRequest{
+		AccountID: "account-1",
+		AssetID:   "usdt",
+		ToAddress: "TBXSw8fM4jpQkGc6zZjsVABFpVN7UvXPdV",
+		Amount:    amount.MustParse("10"),
+		Chain:     "TRX",
+	})
+	if err != nil {
+		t.Fatalf("Transfer: %v", err)
+	}
+	if submitted.Extras == nil || submitted.Extras.Memo != "customer-7" {
+		t.Errorf("Expected the entry memo to be sent, got %+v", submitted.Extras)
+	}
+}
@@ -0,0 +1,121 @@
+package test
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/paratro/paratro-sdk-go/amount"
+	"github.com/paratro/paratro-sdk-go/asset"
+	"github.com/paratro/paratro-sdk-go/transaction"
+)
+
+func TestAmountParseAndFormat(t *testing.T) {
+	tests := []struct {
+		in    string
+		fixed string
+		str   string
+	}{
+		{"0", "0", "0"},
+		{"1.50", "1.50", "1.5"},
+		{"-0.000001", "-0.000001", "-0.000001"},
+		{".5", "0.5", "0.5"},
+		{"123456789012345678901234567890.123456789012345678", "123456789012345678901234567890.123456789012345678", "123456789012345678901234567890.123456789012345678"},
+	}
+
+	for _, tt := range tests {
+		a, err := amount.Parse(tt.in)
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", tt.in, err)
+		}
+		if a.StringFixed() != tt.fixed {
+			t.Errorf("Parse(%q).StringFixed() = %s, expected %s", tt.in, a.StringFixed(), tt.fixed)
+		}
+		if a.String() != tt.str {
+			t.Errorf("Parse(%q).String() = %s, expected %s", tt.in, a, tt.str)
+		}
+	}
+
+	for _, bad := range []string{"", ".", "1.2.3", "1e18", "abc"} {
+		if _, err := amount.Parse(bad); !errors.Is(err, amount.ErrInvalidAmount) {
+			t.Errorf("Parse(%q): expected ErrInvalidAmount, got %v", bad, err)
+		}
+	}
+}
+
+func TestAmountArithmetic(t *testing.T) {
+	a := amount.MustParse("0.1")
+	b := amount.MustParse("0.2")
+
+	if sum := a.Add(b); !sum.Equal(amount.MustParse("0.3")) {
+		t.Errorf("0.1 + 0.2 = %s", sum)
+	}
+	if diff := a.Sub(b); diff.String() != "-0.1" {
+		t.Errorf("0.1 - 0.2 = %s", diff)
+	}
+	if a.Cmp(b) >= 0 {
+		t.Error("Expected 0.1 < 0.2")
+	}
+	if p := b.MulInt64(3); p.String() != "0.6" {
+		t.Errorf("0.2 * 3 = %s", p)
+	}
+
+	if _, err := amount.MustParse("1.0000001").Rescale(6); !errors.Is(err, amount.ErrPrecisionLoss) {
+		t.Errorf("Expected ErrPrecisionLoss, got %v", err)
+	}
+	if tr := amount.MustParse("1.0000009").Truncate(6); tr.String() != "1" {
+		t.Errorf("Truncate = %s", tr)
+	}
+}
+
+func TestAmountBaseUnits(t *testing.T) {
+	usdt := &asset.Asset{Decimals: 6}
+
+	a, err := usdt.ParseAmount("12.5")
+	if err != nil {
+		t.Fatalf("ParseAmount: %v", err)
+	}
+	if a.BaseUnits().String() != "12500000" {
+		t.Errorf("Expected 12500000 base units, got %s", a.BaseUnits())
+	}
+
+	if s := usdt.FromBaseUnits(big.NewInt(1)).String(); s != "0.000001" {
+		t.Errorf("Expected 0.000001, got %s", s)
+	}
+
+	zero := amount.FromBaseUnits(nil, 6)
+	if zero.Sign() != 0 || zero.Decimals() != 6 || zero.BaseUnits().Sign() != 0 {
+		t.Errorf("Expected nil base units to be zero with 6 decimals, got %s", zero)
+	}
+}
+
+func TestAmountJSON(t *testing.T) {
+	var tx transaction.Transaction
+	data := []byte(`{"tx_id":"tx","amount":"1.000000000000000001","fee":0.00021}`)
+	if err := json.Unmarshal(data, &tx); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if tx.Amount.String() != "1.000000000000000001" {
+		t.Errorf("Unexpected amount %s", tx.Amount)
+	}
+	if tx.Fee.String() != "0.00021" {
+		t.Errorf("Unexpected fee %s", tx.Fee)
+	}
+
+	var a asset.Asset
+	if err := json.Unmarshal([]byte(`{"balance":"","decimals":18}`), &a); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if !a.Balance.IsZero() {
+		t.Errorf("Expected empty balance to decode as zero, got %s", a.Balance)
+	}
+
+	out, err := json.Marshal(amount.MustParse("42.10"))
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	if string(out) != `"42.1"` {
+		t.Errorf("Unexpected JSON %s", out)
+	}
+}
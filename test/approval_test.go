@@ -0,0 +1,169 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/paratro/paratro-sdk-go/approval"
+	"github.com/paratro/paratro-sdk-go/transaction"
+)
+
+// fakeApprovals implements the approvals endpoints for a 2-of-N policy
+type fakeApprovals struct {
+	mu        sync.Mutex
+	approvals map[string]*approval.Approval
+}
+
+func newFakeApprovals(api *fakeAPI, txIDs ...string) *fakeApprovals {
+	f := &fakeApprovals{approvals: make(map[string]*approval.Approval)}
+	for _, id := range txIDs {
+		f.approvals[id] = &approval.Approval{
+			TxID:              id,
+			WalletID:          "wallet-1",
+			Status:            approval.StatusPending,
+			RequiredApprovals: 2,
+			Transaction:       &transaction.Transaction{TxID: id, Status: transaction.StatusAwaitingApproval},
+		}
+	}
+
+	api.handle("/api/v1/approvals", func(caller string, r *http.Request) (interface{}, string) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		var items []*approval.Approval
+		for _, id := range txIDs {
+			if a := f.approvals[id]; string(a.Status) == r.URL.Query().Get("status") {
+				items = append(items, a)
+			}
+		}
+		return items, ""
+	})
+	api.handle("/api/v1/approvals/", func(caller string, r *http.Request) (interface{}, string) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/api/v1/approvals/"), "/")
+		a, ok := f.approvals[parts[0]]
+		if !ok {
+			return nil, "approval not found"
+		}
+		if len(parts) == 1 {
+			return a, ""
+		}
+
+		switch parts[1] {
+		case "trail":
+			return a.Decisions, ""
+		case "approve", "reject":
+			if a.Status != approval.StatusPending {
+				return nil, "approval is " + string(a.Status)
+			}
+			if a.HasDecided(caller) {
+				return nil, "approver has already decided"
+			}
+			var body struct {
+				Comment string `json:"comment"`
+			}
+			json.NewDecoder(r.Body).Decode(&body)
+
+			action := approval.ActionApprove
+			if parts[1] == "reject" {
+				action = approval.ActionReject
+			}
+			a.Decisions = append(a.Decisions, &approval.Decision{ApproverID: caller, Action: action, Comment: body.Comment})
+
+			switch {
+			case action == approval.ActionReject:
+				a.Status = approval.StatusRejected
+				a.Transaction.Status = transaction.StatusRejected
+			default:
+				a.Approvals++
+				if a.Remaining() == 0 {
+					a.Status = approval.StatusApproved
+					a.Transaction.Status = transaction.StatusPending
+				}
+			}
+			return a, ""
+		}
+		return nil, "unknown action"
+	})
+	return f
+}
+
+func TestApprovalTwoApprovers(t *testing.T) {
+	api := newFakeAPI(t)
+	newFakeApprovals(api, "tx-1", "tx-2")
+	alice := api.client(t, "alice")
+	bob := api.client(t, "bob")
+	ctx := context.Background()
+
+	pending, err := alice.Approval.ListPending(ctx, &approval.ListPendingRequest{WalletID: "wallet-1"})
+	if err != nil {
+		t.Fatalf("ListPending: %v", err)
+	}
+	if len(pending.Items) != 2 || !pending.Items[0].Transaction.IsAwaitingApproval() {
+		t.Fatalf("Expected 2 transactions awaiting approval, got %+v", pending.Items)
+	}
+
+	a, err := alice.Approval.Approve(ctx, "tx-1", "invoice 1182")
+	if err != nil {
+		t.Fatalf("Approve: %v", err)
+	}
+	if a.Status != approval.StatusPending || a.Remaining() != 1 {
+		t.Errorf("Expected one more approval needed, got %s with %d remaining", a.Status, a.Remaining())
+	}
+
+	if _, err := alice.Approval.Approve(ctx, "tx-1", ""); err == nil {
+		t.Error("Expected a second vote from the same approver to fail")
+	}
+
+	a, err = bob.Approval.Approve(ctx, "tx-1", "")
+	if err != nil {
+		t.Fatalf("Approve: %v", err)
+	}
+	if a.Status != approval.StatusApproved || a.Transaction.Status != transaction.StatusPending {
+		t.Errorf("Expected approved transaction to move to PENDING, got %s / %s", a.Status, a.Transaction.Status)
+	}
+
+	trail, err := bob.Approval.Trail(ctx, "tx-1")
+	if err != nil {
+		t.Fatalf("Trail: %v", err)
+	}
+	if len(trail) != 2 || trail[0].ApproverID != "alice" || trail[0].Comment != "invoice 1182" || trail[1].ApproverID != "bob" {
+		t.Errorf("Unexpected approval trail %+v", trail)
+	}
+
+	pending, err = alice.Approval.ListPending(ctx, nil)
+	if err != nil {
+		t.Fatalf("ListPending: %v", err)
+	}
+	if len(pending.Items) != 1 || pending.Items[0].TxID != "tx-2" {
+		t.Errorf("Expected only tx-2 pending, got %+v", pending.Items)
+	}
+}
+
+func TestApprovalReject(t *testing.T) {
+	api := newFakeAPI(t)
+	newFakeApprovals(api, "tx-1")
+	alice := api.client(t, "alice")
+	bob := api.client(t, "bob")
+	ctx := context.Background()
+
+	if _, err := alice.Approval.Reject(ctx, "tx-1", ""); err == nil {
+		t.Error("Expected reject without a comment to fail")
+	}
+
+	a, err := alice.Approval.Reject(ctx, "tx-1", "unknown beneficiary")
+	if err != nil {
+		t.Fatalf("Reject: %v", err)
+	}
+	if a.Status != approval.StatusRejected || a.Transaction.Status != transaction.StatusRejected {
+		t.Errorf("Expected rejected transaction, got %s / %s", a.Status, a.Transaction.Status)
+	}
+
+	if _, err := bob.Approval.Approve(ctx, "tx-1", ""); err == nil {
+		t.Error("Expected approving a rejected transaction to fail")
+	}
+}
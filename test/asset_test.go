@@ -0,0 +1,224 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/paratro/paratro-sdk-go/asset"
+)
+
+// fakeCatalog serves the token catalog and counts catalog requests
+type fakeCatalog struct {
+	mu       sync.Mutex
+	tokens   []*asset.Token
+	requests int
+}
+
+func newFakeCatalog(api *fakeAPI, tokens []*asset.Token) *fakeCatalog {
+	c := &fakeCatalog{tokens: tokens}
+	api.handle("/api/v1/assets/tokens", func(caller string, r *http.Request) (interface{}, string) {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		c.requests++
+		var out []*asset.Token
+		for _, t := range c.tokens {
+			if string(t.Chain) == r.URL.Query().Get("chain") && string(t.Network) == r.URL.Query().Get("network") {
+				out = append(out, t)
+			}
+		}
+		return out, ""
+	})
+	return c
+}
+
+func (c *fakeCatalog) count() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.requests
+}
+
+func ethTokens() []*asset.Token {
+	return []*asset.Token{
+		{Symbol: "ETH", Chain: "ETH", Network: "mainnet", AssetType: asset.AssetTypeNative, Decimals: 18},
+		{Symbol: "USDC", Chain: "ETH", Network: "mainnet", AssetType: asset.AssetTypeERC20, ContractAddress: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", Decimals: 6},
+		{Symbol: "USDT", Chain: "ETH", Network: "mainnet", AssetType: asset.AssetTypeERC20, ContractAddress: "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359", Decimals: 6},
+		{Symbol: "USDT", Chain: "ETH", Network: "mainnet", AssetType: asset.AssetTypeERC20, ContractAddress: "0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB", Decimals: 6},
+	}
+}
+
+func TestRegistryResolvesSymbolsIgnoringCase(t *testing.T) {
+	api := newFakeAPI(t)
+	newFakeCatalog(api, ethTokens())
+	client := api.client(t, "key")
+	registry := asset.NewRegistry(client.Asset, 0)
+	ctx := context.Background()
+
+	token, err := registry.Resolve(ctx, "ETH", "mainnet", "usdc")
+	if err != nil {
+		t.Fatalf("Failed to resolve usdc: %v", err)
+	}
+	if token.Symbol != "USDC" || token.Decimals != 6 {
+		t.Errorf("Expected USDC with 6 decimals, got %s with %d", token.Symbol, token.Decimals)
+	}
+
+	matches, err := registry.BySymbol(ctx, "ETH", "mainnet", "Usdt")
+	if err != nil {
+		t.Fatalf("Failed to find USDT: %v", err)
+	}
+	if len(matches) != 2 {
+		t.Errorf("Expected 2 USDT tokens, got %d", len(matches))
+	}
+
+	if _, err := registry.Resolve(ctx, "ETH", "mainnet", "USDT"); !errors.Is(err, asset.ErrAmbiguousSymbol) {
+		t.Errorf("Expected ErrAmbiguousSymbol for USDT, got %v", err)
+	}
+	if _, err := registry.Resolve(ctx, "ETH", "mainnet", "DAI"); !errors.Is(err, asset.ErrTokenNotFound) {
+		t.Errorf("Expected ErrTokenNotFound for DAI, got %v", err)
+	}
+}
+
+func TestRegistryFindsContractIgnoringCase(t *testing.T) {
+	api := newFakeAPI(t)
+	newFakeCatalog(api, ethTokens())
+	client := api.client(t, "key")
+	registry := asset.NewRegistry(client.Asset, 0)
+	ctx := context.Background()
+
+	for _, contract := range []string{
+		"0xdbf03b407c01e7cd3cbea99509d93f8dddc8c6fb",
+		"0XDBF03B407C01E7CD3CBEA99509D93F8DDDC8C6FB",
+		" 0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB ",
+	} {
+		token, err := registry.ByContract(ctx, "ETH", "mainnet", contract)
+		if err != nil {
+			t.Errorf("Failed to find contract %q: %v", contract, err)
+			continue
+		}
+		if token.ContractAddress != "0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB" {
+			t.Errorf("Expected contract %q to match the second USDT, got %s", contract, token.ContractAddress)
+		}
+	}
+
+	if _, err := registry.ByContract(ctx, "ETH", "mainnet", "0x0000000000000000000000000000000000000001"); !errors.Is(err, asset.ErrTokenNotFound) {
+		t.Errorf("Expected ErrTokenNotFound for unknown contract, got %v", err)
+	}
+}
+
+func TestRegistryRefreshesAfterTTL(t *testing.T) {
+	api := newFakeAPI(t)
+	catalog := newFakeCatalog(api, ethTokens())
+	client := api.client(t, "key")
+	registry := asset.NewRegistry(client.Asset, 50*time.Millisecond)
+	ctx := context.Background()
+
+	if _, err := registry.Tokens(ctx, "ETH", "mainnet"); err != nil {
+		t.Fatalf("Failed to list tokens: %v", err)
+	}
+	if _, err := registry.Resolve(ctx, "ethereum", "MAINNET", "USDC"); err != nil {
+		t.Fatalf("Failed to resolve USDC: %v", err)
+	}
+	if n := catalog.count(); n != 1 {
+		t.Errorf("Expected 1 catalog request within the TTL, got %d", n)
+	}
+
+	catalog.mu.Lock()
+	catalog.tokens = append(catalog.tokens, &asset.Token{Symbol: "DAI", Chain: "ETH", Network: "mainnet", AssetType: asset.AssetTypeERC20,
+		ContractAddress: "0x6B175474E89094C44Da98b954EedeAC495271d0F", Decimals: 18})
+	catalog.mu.Unlock()
+
+	if _, err := registry.Resolve(ctx, "ETH", "mainnet", "DAI"); !errors.Is(err, asset.ErrTokenNotFound) {
+		t.Errorf("Expected the cached catalog to miss DAI, got %v", err)
+	}
+
+	time.Sleep(60 * time.Millisecond)
+	token, err := registry.Resolve(ctx, "ETH", "mainnet", "DAI")
+	if err != nil {
+		t.Fatalf("Expected DAI after the TTL expired: %v", err)
+	}
+	if token.Decimals != 18 {
+		t.Errorf("Expected 18 decimals for DAI, got %d", token.Decimals)
+	}
+	if n := catalog.count(); n != 2 {
+		t.Errorf("Expected 2 catalog requests, got %d", n)
+	}
+
+	registry.Invalidate()
+	if _, err := registry.Tokens(ctx, "ETH", "mainnet"); err != nil {
+		t.Fatalf("Failed to list tokens: %v", err)
+	}
+	if n := catalog.count(); n != 3 {
+		t.Errorf("Expected Invalidate to force a refetch, got %d requests", n)
+	}
+}
+
+func TestAssetLifecycleCalls(t *testing.T) {
+	api := newFakeAPI(t)
+	var calls []string
+	api.handle("/api/v1/assets/", func(caller string, r *http.Request) (interface{}, string) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		switch r.URL.Path {
+		case "/api/v1/assets/asset-1/refresh":
+			return map[string]interface{}{"asset_id": "asset-1", "status": "ACTIVE", "balance": "12.5", "last_synced_at": 1700000000}, ""
+		case "/api/v1/assets/asset-1/deactivate":
+			return map[string]interface{}{"asset_id": "asset-1", "status": "inactive"}, ""
+		case "/api/v1/assets/asset-1/reactivate":
+			return map[string]interface{}{"asset_id": "asset-1", "status": "ACTIVE"}, ""
+		case "/api/v1/assets/asset-1":
+			return nil, ""
+		}
+		return nil, "asset not found"
+	})
+	client := api.client(t, "key")
+	ctx := context.Background()
+
+	refreshed, err := client.Asset.RefreshBalance(ctx, "asset-1")
+	if err != nil {
+		t.Fatalf("Failed to refresh balance: %v", err)
+	}
+	if refreshed.Balance.String() != "12.5" || refreshed.LastSyncedAt.Unix() != 1700000000 {
+		t.Errorf("Expected balance 12.5 synced at 1700000000, got %s at %v", refreshed.Balance, refreshed.LastSyncedAt)
+	}
+
+	inactive, err := client.Asset.Deactivate(ctx, "asset-1")
+	if err != nil {
+		t.Fatalf("Failed to deactivate asset: %v", err)
+	}
+	if inactive.Status != asset.StatusInactive {
+		t.Errorf("Expected status %s, got %s", asset.StatusInactive, inactive.Status)
+	}
+
+	active, err := client.Asset.Reactivate(ctx, "asset-1")
+	if err != nil {
+		t.Fatalf("Failed to reactivate asset: %v", err)
+	}
+	if active.Status != asset.StatusActive {
+		t.Errorf("Expected status %s, got %s", asset.StatusActive, active.Status)
+	}
+
+	if err := client.Asset.Delete(ctx, "asset-1"); err != nil {
+		t.Fatalf("Failed to delete asset: %v", err)
+	}
+	if _, err := client.Asset.Deactivate(ctx, "asset-2"); err == nil {
+		t.Error("Expected an error deactivating an unknown asset")
+	}
+
+	want := []string{
+		"POST /api/v1/assets/asset-1/refresh",
+		"POST /api/v1/assets/asset-1/deactivate",
+		"POST /api/v1/assets/asset-1/reactivate",
+		"DELETE /api/v1/assets/asset-1",
+		"POST /api/v1/assets/asset-2/deactivate",
+	}
+	if len(calls) != len(want) {
+		t.Fatalf("Expected calls %v, got %v", want, calls)
+	}
+	for i := range want {
+		if calls[i] != want[i] {
+			t.Errorf("Expected call %d to be %q, got %q", i, want[i], calls[i])
+		}
+	}
+}
@@ -0,0 +1,176 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/paratro/paratro-sdk-go/common"
+	"github.com/paratro/paratro-sdk-go/deposit"
+	"github.com/paratro/paratro-sdk-go/transaction"
+)
+
+// fakeReceives serves RECEIVE transactions whose confirmations tests can change
+type fakeReceives struct {
+	mu  sync.Mutex
+	txs []*transaction.Transaction
+}
+
+func (f *fakeReceives) setConfirmations(txID string, confirmations int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, tx := range f.txs {
+		if tx.TxID == txID {
+			tx.Confirmations = confirmations
+		}
+	}
+}
+
+func pollEvents(t *testing.T, w *deposit.Watcher) []string {
+	out := make(chan *deposit.Event, 10)
+	if err := w.Poll(context.Background(), out); err != nil {
+		t.Fatalf("Poll: %v", err)
+	}
+	close(out)
+
+	var events []string
+	for e := range out {
+		events = append(events, e.Type+" "+e.Transaction.TxID)
+	}
+	return events
+}
+
+func TestDepositWatcher(t *testing.T) {
+	api := newFakeAPI(t)
+	receives := &fakeReceives{txs: []*transaction.Transaction{
+		{TxID: "tx-1", Chain: "ETH", TxType: transaction.TxTypeReceive, Status: transaction.StatusConfirming, Confirmations: 5, CreatedAt: mustTime("2024-01-10T10:00:00Z")},
+		{TxID: "tx-2", Chain: "ETH", TxType: transaction.TxTypeReceive, Status: transaction.StatusConfirming, Confirmations: 1, CreatedAt: mustTime("2024-01-10T10:05:00Z")},
+		{TxID: "tx-old", Chain: "ETH", TxType: transaction.TxTypeReceive, Status: transaction.StatusConfirmed, Confirmations: 900, CreatedAt: mustTime("2024-01-01T00:00:00Z")},
+	}}
+	api.handle("/api/v1/transactions", func(caller string, r *http.Request) (interface{}, string) {
+		receives.mu.Lock()
+		defer receives.mu.Unlock()
+		from, _ := time.Parse(time.RFC3339, r.URL.Query().Get("created_from"))
+		var items []transaction.Transaction
+		for _, tx := range receives.txs {
+			if !tx.CreatedAt.Before(from) {
+				items = append(items, *tx)
+			}
+		}
+		return items, ""
+	})
+
+	client := api.client(t, "key")
+	store := deposit.NewFileCursorStore(filepath.Join(t.TempDir(), "cursor.json"))
+	newWatcher := func() *deposit.Watcher {
+		w := deposit.NewWatcher(client.Transaction, store)
+		w.Confirmations = map[common.Chain]int{"ethereum": 3}
+		w.Since = time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+		return w
+	}
+	w := newWatcher()
+
+	expect := func(step string, got []string, want ...string) {
+		if len(got) != len(want) {
+			t.Fatalf("%s: expected %v, got %v", step, want, got)
+		}
+		for i := range want {
+			if got[i] != want[i] {
+				t.Fatalf("%s: expected %v, got %v", step, want, got)
+			}
+		}
+	}
+
+	expect("first poll", pollEvents(t, w), "CREDITED tx-1")
+	expect("no changes", pollEvents(t, w))
+
+	receives.setConfirmations("tx-2", 3)
+	receives.setConfirmations("tx-1", 2)
+	expect("reorg", pollEvents(t, w), "REVERTED tx-1", "CREDITED tx-2")
+
+	expect("restart", pollEvents(t, newWatcher()))
+
+	receives.setConfirmations("tx-1", 10)
+	receives.setConfirmations("tx-2", 10)
+	expect("reconfirmed", pollEvents(t, newWatcher()), "CREDITED tx-1")
+
+	cursor, err := store.Load(context.Background())
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if !cursor.Since.Equal(time.Date(2024, 1, 10, 10, 5, 0, 0, time.UTC)) || len(cursor.Credited) != 1 {
+		t.Errorf("Expected final deposits to be pruned behind the window, got %+v", cursor)
+	}
+	expect("after pruning", pollEvents(t, newWatcher()))
+}
+
+func TestDepositWatcherSubSecondWindow(t *testing.T) {
+	api := newFakeAPI(t)
+	receives := &fakeReceives{txs: []*transaction.Transaction{
+		{TxID: "tx-final", Chain: "ETH", TxType: transaction.TxTypeReceive, Status: transaction.StatusConfirmed, Confirmations: 10, CreatedAt: mustTime("2024-01-10T10:05:00.2Z")},
+		{TxID: "tx-open", Chain: "ETH", TxType: transaction.TxTypeReceive, Status: transaction.StatusConfirming, Confirmations: 3, CreatedAt: mustTime("2024-01-10T10:05:00.7Z")},
+	}}
+	api.handle("/api/v1/transactions", func(caller string, r *http.Request) (interface{}, string) {
+		receives.mu.Lock()
+		defer receives.mu.Unlock()
+		from, _ := time.Parse(time.RFC3339, r.URL.Query().Get("created_from"))
+		var items []transaction.Transaction
+		for _, tx := range receives.txs {
+			if !tx.CreatedAt.Before(from) {
+				items = append(items, *tx)
+			}
+		}
+		return items, ""
+	})
+
+	client := api.client(t, "key")
+	w := deposit.NewWatcher(client.Transaction, deposit.NewMemoryCursorStore())
+	w.Confirmations = map[common.Chain]int{common.ChainEthereum: 3}
+	w.Since = time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+
+	if got := pollEvents(t, w); len(got) != 2 {
+		t.Fatalf("Expected both deposits to be credited, got %v", got)
+	}
+	// The window now starts at 10:05:00.7 but is listed from 10:05:00
+	if got := pollEvents(t, w); len(got) != 0 {
+		t.Errorf("Expected no events from the same-second final deposit, got %v", got)
+	}
+}
+
+func TestDepositWatcherRevertsDroppedDeposit(t *testing.T) {
+	api := newFakeAPI(t)
+	receives := &fakeReceives{txs: []*transaction.Transaction{
+		{TxID: "tx-1", Chain: "ETH", TxHash: "0xabc", TxType: transaction.TxTypeReceive, Status: transaction.StatusConfirming, Confirmations: 3, CreatedAt: mustTime("2024-01-10T10:00:00Z")},
+	}}
+	api.handle("/api/v1/transactions", func(caller string, r *http.Request) (interface{}, string) {
+		receives.mu.Lock()
+		defer receives.mu.Unlock()
+		var items []transaction.Transaction
+		for _, tx := range receives.txs {
+			items = append(items, *tx)
+		}
+		return items, ""
+	})
+
+	client := api.client(t, "key")
+	w := deposit.NewWatcher(client.Transaction, deposit.NewMemoryCursorStore())
+	w.Confirmations = map[common.Chain]int{common.ChainEthereum: 3}
+	w.Since = time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+
+	if got := pollEvents(t, w); len(got) != 1 || got[0] != "CREDITED tx-1" {
+		t.Fatalf("Expected tx-1 to be credited, got %v", got)
+	}
+
+	receives.mu.Lock()
+	receives.txs = nil
+	receives.mu.Unlock()
+	if got := pollEvents(t, w); len(got) != 1 || got[0] != "REVERTED tx-1" {
+		t.Fatalf("Expected the dropped deposit to be reverted, got %v", got)
+	}
+	if got := pollEvents(t, w); len(got) != 0 {
+		t.Errorf("Expected a single revert, got %v", got)
+	}
+}
@@ -0,0 +1,72 @@
+package test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/paratro/paratro-sdk-go/account"
+	"github.com/paratro/paratro-sdk-go/derivation"
+)
+
+func TestDerivationPathParse(t *testing.T) {
+	p, err := derivation.Parse("m/84h/0'/0'/1/7")
+	if err != nil {
+		t.Fatalf("Failed to parse path: %v", err)
+	}
+
+	if p.String() != "m/84'/0'/0'/1/7" {
+		t.Errorf("Unexpected formatted path %s", p)
+	}
+	if !p.IsBIP44Layout() {
+		t.Error("Expected BIP-44 layout")
+	}
+	if p.Purpose() != 84 || p.CoinType() != 0 || p.Account() != 0 || p.Change() != 1 || p.Index() != 7 {
+		t.Errorf("Unexpected components %v", p)
+	}
+
+	for _, bad := range []string{"44'/60'/0'/0/0", "m/abc", "m/2147483648"} {
+		if _, err := derivation.Parse(bad); !errors.Is(err, derivation.ErrInvalidPath) {
+			t.Errorf("Parse(%q): expected ErrInvalidPath, got %v", bad, err)
+		}
+	}
+}
+
+func TestDerivationValidateForChain(t *testing.T) {
+	tests := []struct {
+		path    derivation.Path
+		chain   string
+		network string
+		valid   bool
+	}{
+		{derivation.BIP44(derivation.CoinTypeETH, 0, 0, 3), "ETH", "mainnet", true},
+		{derivation.BIP44(derivation.CoinTypeTRX, 0, 0, 3), "TRX", "mainnet", true},
+		{derivation.BIP84(derivation.CoinTypeBTC, 0, 0, 3), "BTC", "mainnet", true},
+		{derivation.BIP84(derivation.CoinTypeBTCTestnet, 0, 0, 3), "BTC", "testnet", true},
+		{derivation.BIP84(derivation.CoinTypeETH, 0, 0, 3), "ETH", "mainnet", false},
+		{derivation.BIP44(derivation.CoinTypeETH, 0, 0, 3), "TRX", "mainnet", false},
+	}
+
+	for _, tt := range tests {
+		err := derivation.ValidateForChain(tt.path, tt.chain, tt.network)
+		if (err == nil) != tt.valid {
+			t.Errorf("ValidateForChain(%s, %s): valid=%v, got %v", tt.path, tt.chain, tt.valid, err)
+		}
+	}
+}
+
+func TestAccountValidateDerivationPath(t *testing.T) {
+	a := &account.Account{
+		Chain:          "ethereum",
+		Network:        "mainnet",
+		DerivationPath: "m/44'/60'/0'/0/5",
+		AddressIndex:   5,
+	}
+	if err := a.ValidateDerivationPath(); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	a.AddressIndex = 6
+	if err := a.ValidateDerivationPath(); !errors.Is(err, derivation.ErrInvalidPath) {
+		t.Errorf("Expected index mismatch, got %v", err)
+	}
+}
@@ -0,0 +1,130 @@
+package test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/paratro/paratro-sdk-go/account"
+	"github.com/paratro/paratro-sdk-go/approval"
+	"github.com/paratro/paratro-sdk-go/common"
+	"github.com/paratro/paratro-sdk-go/transaction"
+	"github.com/paratro/paratro-sdk-go/wallet"
+)
+
+func TestParseChain(t *testing.T) {
+	tests := []struct {
+		in   string
+		want common.Chain
+	}{
+		{"ETH", common.ChainEthereum},
+		{"ethereum", common.ChainEthereum},
+		{" tron ", common.ChainTron},
+		{"bitcoin", common.ChainBitcoin},
+		{"matic", common.ChainPolygon},
+		{"xrp", common.ChainXRP},
+	}
+	for _, tt := range tests {
+		got, err := common.ParseChain(tt.in)
+		if err != nil || got != tt.want {
+			t.Errorf("ParseChain(%q) = %q, %v; want %q", tt.in, got, err, tt.want)
+		}
+	}
+
+	if _, err := common.ParseChain("DOGE"); !errors.Is(err, common.ErrUnknownValue) {
+		t.Errorf("Expected ErrUnknownValue for an unknown chain, got %v", err)
+	}
+	if common.Chain("DOGE").IsKnown() || !common.ChainTron.IsKnown() {
+		t.Error("IsKnown should report only the Chain constants")
+	}
+}
+
+func TestParseStatuses(t *testing.T) {
+	if s, err := transaction.ParseStatus("confirmed"); err != nil || s != transaction.StatusConfirmed {
+		t.Errorf("ParseStatus(confirmed) = %q, %v", s, err)
+	}
+	if tt, err := transaction.ParseTxType("Receive"); err != nil || tt != transaction.TxTypeReceive {
+		t.Errorf("ParseTxType(Receive) = %q, %v", tt, err)
+	}
+	if _, err := account.ParseStatus("ARCHIVED"); !errors.Is(err, common.ErrUnknownValue) {
+		t.Errorf("Expected ErrUnknownValue for an unknown account status, got %v", err)
+	}
+	if n, err := common.ParseNetwork("Mainnet"); err != nil || n != common.NetworkMainnet {
+		t.Errorf("ParseNetwork(Mainnet) = %q, %v", n, err)
+	}
+	if wallet.Status("active").IsKnown() || !wallet.StatusFrozen.IsKnown() {
+		t.Error("IsKnown should report only the Status constants")
+	}
+}
+
+func TestEnumUnmarshalIsLenient(t *testing.T) {
+	var tx transaction.Transaction
+	data := `{"tx_id":"tx-1","chain":"ethereum","network":"MAINNET","status":"confirmed","tx_type":"INTERNAL"}`
+	if err := json.Unmarshal([]byte(data), &tx); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if tx.Status != transaction.StatusConfirmed {
+		t.Errorf("Expected the status to be normalized, got %q", tx.Status)
+	}
+	if tx.Chain != "ethereum" || tx.Network != "MAINNET" {
+		t.Errorf("Expected chain and network to be kept as sent, got %q %q", tx.Chain, tx.Network)
+	}
+	if tx.Chain.Canonical() != common.ChainEthereum {
+		t.Errorf("Expected chain %q to canonicalize to ETH, got %q", tx.Chain, tx.Chain.Canonical())
+	}
+	out, err := json.Marshal(&tx)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	var echoed map[string]interface{}
+	json.Unmarshal(out, &echoed)
+	if echoed["chain"] != "ethereum" || echoed["network"] != "MAINNET" {
+		t.Errorf("Expected chain and network to marshal back as sent, got %s", out)
+	}
+	if tx.TxType != "INTERNAL" || tx.TxType.IsKnown() {
+		t.Errorf("Expected the unknown tx_type to be kept as sent, got %q", tx.TxType)
+	}
+
+	var acct account.Account
+	if err := json.Unmarshal([]byte(`{"chain":"SOL","status":"ARCHIVED"}`), &acct); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if acct.Chain != "SOL" || acct.Status != "ARCHIVED" {
+		t.Errorf("Expected unknown values to be kept as sent, got %q %q", acct.Chain, acct.Status)
+	}
+
+	out, err = json.Marshal(&acct)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	var round map[string]interface{}
+	json.Unmarshal(out, &round)
+	if round["chain"] != "SOL" || round["status"] != "ARCHIVED" {
+		t.Errorf("Expected unknown values to marshal back unchanged, got %s", out)
+	}
+
+	if err := json.Unmarshal([]byte(`{"status":42}`), &acct); err == nil {
+		t.Error("Expected an error for a non-string status")
+	}
+}
+
+func TestBatchAndApprovalStatusesAreTyped(t *testing.T) {
+	var batch transaction.Batch
+	if err := json.Unmarshal([]byte(`{"batch_id":"b-1","status":"partially_failed"}`), &batch); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if batch.Status != transaction.BatchStatusPartiallyFailed || !batch.Done() {
+		t.Errorf("Expected a done PARTIALLY_FAILED batch, got %q", batch.Status)
+	}
+
+	var a approval.Approval
+	if err := json.Unmarshal([]byte(`{"tx_id":"tx-1","status":"expired"}`), &a); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if a.Status != approval.StatusExpired {
+		t.Errorf("Expected EXPIRED, got %q", a.Status)
+	}
+	if _, err := approval.ParseStatus("WITHDRAWN"); !errors.Is(err, common.ErrUnknownValue) {
+		t.Errorf("Expected ErrUnknownValue, got %v", err)
+	}
+}
@@ -0,0 +1,59 @@
+package test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	mpcsdk "github.com/paratro/paratro-sdk-go"
+	"github.com/paratro/paratro-sdk-go/configuration"
+)
+
+// fakeAPI is an in-process stand-in for the MPC Wallet Gateway. It issues a
+// JWT equal to the caller's API key, so handlers can tell callers apart, and
+// wraps handler results in the standard response envelope.
+type fakeAPI struct {
+	server *httptest.Server
+	mux    *http.ServeMux
+}
+
+func newFakeAPI(t *testing.T) *fakeAPI {
+	f := &fakeAPI{mux: http.NewServeMux()}
+	f.mux.HandleFunc("/api/v1/auth/token", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"code": 200000,
+			"data": map[string]interface{}{
+				"token":      r.Header.Get("X-API-Key"),
+				"expires_in": 3600,
+			},
+		})
+	})
+	f.server = httptest.NewServer(f.mux)
+	t.Cleanup(f.server.Close)
+	return f
+}
+
+// client returns an SDK client authenticated as apiKey
+func (f *fakeAPI) client(t *testing.T, apiKey string) *mpcsdk.Client {
+	client, err := mpcsdk.NewClient(apiKey, "secret", configuration.Custom(f.server.URL))
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	return client
+}
+
+// handle registers a handler; it returns the response data or an error
+// message, and receives the caller's API key
+func (f *fakeAPI) handle(pattern string, h func(caller string, r *http.Request) (interface{}, string)) {
+	f.mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+		caller := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		data, errMsg := h(caller, r)
+		if errMsg != "" {
+			json.NewEncoder(w).Encode(map[string]interface{}{"code": 400000, "message": errMsg})
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"code": 200000, "data": data})
+	})
+}
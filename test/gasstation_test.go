@@ -0,0 +1,226 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/paratro/paratro-sdk-go/account"
+	"github.com/paratro/paratro-sdk-go/amount"
+	"github.com/paratro/paratro-sdk-go/asset"
+	"github.com/paratro/paratro-sdk-go/common"
+	"github.com/paratro/paratro-sdk-go/gasstation"
+)
+
+func newGasStation(t *testing.T, log gasstation.AuditLog) (*gasstation.Station, *fakeWallet) {
+	api := newFakeAPI(t)
+	wallet := newFakeWallet(api,
+		[]account.Account{
+			ethAccount("funding", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"),
+			ethAccount("dep-a", "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359"),
+			ethAccount("dep-b", "0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB"),
+		},
+		[]*asset.Asset{
+			{AssetID: "funding-eth", AccountID: "funding", Symbol: "ETH", AssetType: asset.AssetTypeNative, Balance: amount.MustParse("1")},
+			{AssetID: "a-usdt", AccountID: "dep-a", Symbol: "USDT", AssetType: asset.AssetTypeERC20, Balance: amount.MustParse("500")},
+			{AssetID: "a-eth", AccountID: "dep-a", Symbol: "ETH", AssetType: asset.AssetTypeNative, Balance: amount.MustParse("0.001")},
+			{AssetID: "b-usdt", AccountID: "dep-b", Symbol: "USDT", AssetType: asset.AssetTypeERC20, Balance: amount.MustParse("20")},
+		},
+	)
+
+	client := api.client(t, "key")
+	station := gasstation.NewStation(client.Account, client.Asset, client.Transaction, log)
+	station.WalletID = "wallet-1"
+	station.Funding[common.ChainEthereum] = &gasstation.Funding{
+		AccountID:     "funding",
+		MinBalance:    amount.MustParse("0.005"),
+		TargetBalance: amount.MustParse("0.02"),
+		MaxTopUp:      amount.MustParse("0.015"),
+	}
+	return station, wallet
+}
+
+func TestGasStationTopsUpTokenAccounts(t *testing.T) {
+	log := gasstation.NewMemoryAuditLog()
+	station, wallet := newGasStation(t, log)
+
+	ctx := context.Background()
+	records, err := station.CheckOnce(ctx)
+	if err != nil {
+		t.Fatalf("CheckOnce: %v", err)
+	}
+	if len(records) != 2 || len(wallet.transfers) != 2 {
+		t.Fatalf("Expected two top-ups, got %+v", records)
+	}
+
+	a, b := records[0], records[1]
+	if a.Outcome != gasstation.OutcomeSubmitted || !a.Amount.Equal(amount.MustParse("0.015")) {
+		t.Errorf("Expected dep-a to receive 0.019 capped to 0.015, got %+v", a)
+	}
+	if b.Outcome != gasstation.OutcomeSubmitted || !b.Amount.Equal(amount.MustParse("0.015")) || !b.BalanceBefore.IsZero() {
+		t.Errorf("Expected dep-b with no native asset to be topped up, got %+v", b)
+	}
+	for _, tr := range wallet.transfers {
+		if tr.AccountID != "funding" || tr.AssetID != "funding-eth" {
+			t.Errorf("Expected top-ups from the funding account, got %+v", tr)
+		}
+	}
+
+	// Both accounts are still low, but were just topped up
+	records, err = station.CheckOnce(ctx)
+	if err != nil {
+		t.Fatalf("CheckOnce: %v", err)
+	}
+	if len(wallet.transfers) != 2 || len(records) != 2 || records[0].Reason != "cooldown" {
+		t.Errorf("Expected the cooldown to skip both accounts, got %+v", records)
+	}
+
+	// Two intents, their outcomes and two skips
+	logged, _ := log.Since(ctx, time.Now().Add(-time.Hour))
+	if len(logged) != 6 {
+		t.Errorf("Expected every decision to be logged, got %d records", len(logged))
+	}
+}
+
+func TestGasStationDailyCap(t *testing.T) {
+	log := gasstation.NewJSONLinesAuditLog(filepath.Join(t.TempDir(), "gas.jsonl"))
+	station, wallet := newGasStation(t, log)
+	station.Funding[common.ChainEthereum].DailyCap = amount.MustParse("0.02")
+
+	ctx := context.Background()
+	records, err := station.CheckOnce(ctx)
+	if err != nil {
+		t.Fatalf("CheckOnce: %v", err)
+	}
+	if len(wallet.transfers) != 1 {
+		t.Fatalf("Expected the daily cap to allow one top-up, got %d", len(wallet.transfers))
+	}
+	if records[1].Outcome != gasstation.OutcomeSkipped || records[1].Reason != "daily cap reached" {
+		t.Errorf("Expected the second top-up to hit the daily cap, got %+v", records[1])
+	}
+
+	logged, err := log.Since(ctx, time.Now().Add(-time.Hour))
+	if err != nil {
+		t.Fatalf("Since: %v", err)
+	}
+	if len(logged) != 3 || logged[0].Outcome != gasstation.OutcomePending || logged[1].TxID == "" ||
+		logged[1].IdempotencyKey != logged[0].IdempotencyKey || logged[1].Address != "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359" {
+		t.Errorf("Expected the file log to hold the intent and both decisions, got %+v", logged)
+	}
+}
+
+func TestGasStationFundsEachChainSeparately(t *testing.T) {
+	trxAccount := func(id, addr string) account.Account {
+		return account.Account{AccountID: id, Address: addr, Chain: "TRX", Network: "mainnet", Status: account.StatusActive}
+	}
+	api := newFakeAPI(t)
+	wallet := newFakeWallet(api,
+		[]account.Account{
+			ethAccount("eth-funding", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"),
+			ethAccount("eth-dep", "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359"),
+			trxAccount("trx-funding", "TBXSw8fM4jpQkGc6zZjsVABFpVN7UvXPdV"),
+			trxAccount("trx-dep", "TD5gsCwxykWsLN9aPrq2TAfNjByuZKYp4E"),
+			{AccountID: "eth-testnet", Address: "0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB", Chain: "ETH", Network: "testnet", Status: account.StatusActive},
+			{AccountID: "btc-dep", Address: "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", Chain: "BTC", Network: "mainnet", Status: account.StatusActive},
+		},
+		[]*asset.Asset{
+			{AssetID: "eth-funding-eth", AccountID: "eth-funding", Symbol: "ETH", AssetType: asset.AssetTypeNative, Balance: amount.MustParse("1")},
+			{AssetID: "trx-funding-trx", AccountID: "trx-funding", Symbol: "TRX", AssetType: asset.AssetTypeNative, Balance: amount.MustParse("1000")},
+			{AssetID: "eth-dep-usdt", AccountID: "eth-dep", Symbol: "USDT", AssetType: asset.AssetTypeERC20, Balance: amount.MustParse("50")},
+			{AssetID: "trx-dep-usdt", AccountID: "trx-dep", Symbol: "USDT", AssetType: asset.AssetTypeTRC20, Balance: amount.MustParse("50")},
+			{AssetID: "trx-dep-trx", AccountID: "trx-dep", Symbol: "TRX", AssetType: asset.AssetTypeNative, Balance: amount.MustParse("5")},
+			{AssetID: "testnet-usdt", AccountID: "eth-testnet", Symbol: "USDT", AssetType: asset.AssetTypeERC20, Balance: amount.MustParse("50")},
+			{AssetID: "btc-token", AccountID: "btc-dep", Symbol: "RUNE", AssetType: "BRC20", Balance: amount.MustParse("50")},
+		},
+	)
+
+	client := api.client(t, "key")
+	station := gasstation.NewStation(client.Account, client.Asset, client.Transaction, gasstation.NewMemoryAuditLog())
+	station.WalletID = "wallet-1"
+	station.Funding[common.ChainEthereum] = &gasstation.Funding{
+		AccountID:     "eth-funding",
+		MinBalance:    amount.MustParse("0.005"),
+		TargetBalance: amount.MustParse("0.02"),
+	}
+	station.Funding[common.ChainTron] = &gasstation.Funding{
+		AccountID:     "trx-funding",
+		MinBalance:    amount.MustParse("30"),
+		TargetBalance: amount.MustParse("100"),
+	}
+
+	records, err := station.CheckOnce(context.Background())
+	if err != nil {
+		t.Fatalf("CheckOnce: %v", err)
+	}
+	if len(records) != 2 || len(wallet.transfers) != 2 {
+		t.Fatalf("Expected one top-up per funded chain, got %+v", records)
+	}
+
+	want := map[string]struct {
+		from, asset, amount string
+		chain               common.Chain
+	}{
+		"0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359": {"eth-funding", "eth-funding-eth", "0.02", common.ChainEthereum},
+		"TD5gsCwxykWsLN9aPrq2TAfNjByuZKYp4E":         {"trx-funding", "trx-funding-trx", "95", common.ChainTron},
+	}
+	for _, tr := range wallet.transfers {
+		w, ok := want[tr.ToAddress]
+		if !ok {
+			t.Errorf("Unexpected top-up to %s", tr.ToAddress)
+			continue
+		}
+		if tr.AccountID != w.from || tr.AssetID != w.asset || tr.Chain != w.chain || !tr.Amount.Equal(amount.MustParse(w.amount)) {
+			t.Errorf("Expected %s %s from %s, got %+v", w.amount, w.asset, w.from, tr)
+		}
+	}
+}
+
+// crashingAuditLog fails to record submitted top-ups, as if the process
+// stopped between submitting a transfer and logging it
+type crashingAuditLog struct {
+	*gasstation.MemoryAuditLog
+	crash bool
+}
+
+func (c *crashingAuditLog) Record(ctx context.Context, topUp *gasstation.TopUp) error {
+	if c.crash && topUp.Outcome == gasstation.OutcomeSubmitted {
+		return errors.New("process stopped")
+	}
+	return c.MemoryAuditLog.Record(ctx, topUp)
+}
+
+func TestGasStationResubmitsInterruptedTopUp(t *testing.T) {
+	log := &crashingAuditLog{MemoryAuditLog: gasstation.NewMemoryAuditLog(), crash: true}
+	station, wallet := newGasStation(t, log)
+	station.Funding[common.ChainEthereum].DailyCap = amount.MustParse("0.02")
+
+	ctx := context.Background()
+	if _, err := station.CheckOnce(ctx); err == nil {
+		t.Fatal("Expected the interrupted check to fail")
+	}
+	if len(wallet.transfers) != 1 {
+		t.Fatalf("Expected one transfer before the interruption, got %d", len(wallet.transfers))
+	}
+
+	log.crash = false
+	records, err := station.CheckOnce(ctx)
+	if err != nil {
+		t.Fatalf("CheckOnce: %v", err)
+	}
+	if len(wallet.transfers) != 2 || wallet.transfers[1].IdempotencyKey != wallet.transfers[0].IdempotencyKey {
+		t.Fatalf("Expected the top-up to be resubmitted with the same key, got %+v", wallet.transfers)
+	}
+	// The unresolved intent counts towards the daily cap, so dep-b is skipped
+	if len(records) != 2 || records[0].Outcome != gasstation.OutcomeSubmitted || records[1].Reason != "daily cap reached" {
+		t.Errorf("Expected the resubmitted top-up and a capped skip, got %+v", records)
+	}
+
+	if _, err := station.CheckOnce(ctx); err != nil {
+		t.Fatalf("CheckOnce: %v", err)
+	}
+	if len(wallet.transfers) != 2 {
+		t.Errorf("Expected no further transfers once the top-up is logged, got %d", len(wallet.transfers))
+	}
+}
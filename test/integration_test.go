@@ -4,10 +4,13 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 	"testing"
+	"time"
 
 	mpcsdk "github.com/paratro/paratro-sdk-go"
 	"github.com/paratro/paratro-sdk-go/account"
+	"github.com/paratro/paratro-sdk-go/amount"
 	"github.com/paratro/paratro-sdk-go/asset"
 	"github.com/paratro/paratro-sdk-go/configuration"
 	"github.com/paratro/paratro-sdk-go/transaction"
@@ -231,6 +234,162 @@ func TestAccountList(t *testing.T) {
 	t.Logf("✓ Found %d accounts (Total: %d)", len(resp.Items), resp.TotalCount)
 }
 
+func TestAccountGetByAddress(t *testing.T) {
+	if os.Getenv("SKIP_INTEGRATION_TESTS") == "true" {
+		t.Skip("Skipping integration tests")
+	}
+
+	client := getTestClient(t)
+	ctx := context.Background()
+
+	w, err := client.Wallet.Create(ctx, &wallet.CreateWalletRequest{
+		WalletName: "Test Account Lookup Wallet",
+		Chain:      "ETH",
+		Network:    "mainnet",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create wallet: %v", err)
+	}
+
+	created, err := client.Account.Create(ctx, &account.CreateAccountRequest{
+		WalletID: w.WalletID,
+		Chain:    "ethereum",
+		Network:  "mainnet",
+		Label:    "Test Account",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create account: %v", err)
+	}
+
+	a, err := client.Account.GetByAddress(ctx, created.Chain, created.Address)
+	if err != nil {
+		t.Fatalf("Failed to get account by address: %v", err)
+	}
+	if a.AccountID != created.AccountID {
+		t.Errorf("Expected account ID %s, got %s", created.AccountID, a.AccountID)
+	}
+
+	cache := account.NewAddressCache(client.Account)
+	if _, err := cache.Warm(ctx, &account.ListAccountsRequest{WalletID: w.WalletID}); err != nil {
+		t.Fatalf("Failed to warm address cache: %v", err)
+	}
+	if _, ok := cache.Lookup(created.Chain, created.Address); !ok {
+		t.Error("Expected account to be cached after warming")
+	}
+
+	t.Logf("✓ Found account %s by address %s", a.AccountID, a.Address)
+}
+
+func TestAccountUpdateAndFreeze(t *testing.T) {
+	if os.Getenv("SKIP_INTEGRATION_TESTS") == "true" {
+		t.Skip("Skipping integration tests")
+	}
+
+	client := getTestClient(t)
+	ctx := context.Background()
+
+	w, err := client.Wallet.Create(ctx, &wallet.CreateWalletRequest{
+		WalletName: "Test Account Update Wallet",
+		Chain:      "ETH",
+		Network:    "mainnet",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create wallet: %v", err)
+	}
+
+	created, err := client.Account.Create(ctx, &account.CreateAccountRequest{
+		WalletID: w.WalletID,
+		Chain:    "ethereum",
+		Network:  "mainnet",
+		Label:    "Test Account",
+		Metadata: map[string]string{"customer_id": "cus_123"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create account: %v", err)
+	}
+
+	updated, err := client.Account.Update(ctx, created.AccountID, &account.UpdateAccountRequest{
+		Label:    "Renamed Account",
+		Metadata: map[string]string{"tier": "gold"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to update account: %v", err)
+	}
+	if updated.Label != "Renamed Account" {
+		t.Errorf("Expected label %q, got %q", "Renamed Account", updated.Label)
+	}
+
+	frozen, err := client.Account.Freeze(ctx, created.AccountID)
+	if err != nil {
+		t.Fatalf("Failed to freeze account: %v", err)
+	}
+	if frozen.Status != account.StatusFrozen {
+		t.Errorf("Expected status %s, got %s", account.StatusFrozen, frozen.Status)
+	}
+
+	active, err := client.Account.Unfreeze(ctx, created.AccountID)
+	if err != nil {
+		t.Fatalf("Failed to unfreeze account: %v", err)
+	}
+	if active.Status != account.StatusActive {
+		t.Errorf("Expected status %s, got %s", account.StatusActive, active.Status)
+	}
+
+	deleted, err := client.Account.Delete(ctx, created.AccountID)
+	if err != nil {
+		t.Fatalf("Failed to delete account: %v", err)
+	}
+	if deleted.Status != account.StatusDeleted {
+		t.Errorf("Expected status %s, got %s", account.StatusDeleted, deleted.Status)
+	}
+
+	t.Logf("✓ Updated, froze, unfroze and deleted account: %s", created.AccountID)
+}
+
+func TestAccountBatchCreate(t *testing.T) {
+	if os.Getenv("SKIP_INTEGRATION_TESTS") == "true" {
+		t.Skip("Skipping integration tests")
+	}
+
+	client := getTestClient(t)
+	ctx := context.Background()
+
+	w, err := client.Wallet.Create(ctx, &wallet.CreateWalletRequest{
+		WalletName: "Test Account Batch Wallet",
+		Chain:      "ETH",
+		Network:    "mainnet",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create wallet: %v", err)
+	}
+
+	resp, err := client.Account.BatchCreate(ctx, &account.BatchCreateAccountsRequest{
+		WalletID:      w.WalletID,
+		Chain:         "ethereum",
+		Network:       "mainnet",
+		LabelTemplate: "deposit-{index}",
+		Count:         5,
+		Concurrency:   2,
+	})
+	if err != nil {
+		t.Fatalf("Failed to batch create accounts: %v", err)
+	}
+
+	if resp.Succeeded+resp.Failed != 5 {
+		t.Errorf("Expected 5 results, got %d", resp.Succeeded+resp.Failed)
+	}
+	for _, item := range resp.FailedItems() {
+		t.Errorf("Failed to create %s: %v", item.Label, item.Err)
+	}
+
+	next, err := client.Account.NextAddressIndex(ctx, w.WalletID, "ethereum", "mainnet")
+	if err != nil {
+		t.Fatalf("Failed to get next address index: %v", err)
+	}
+
+	t.Logf("✓ Batch created %d accounts (next index: %d)", resp.Succeeded, next)
+}
+
 // ============ Asset Tests ============
 
 func TestAssetCreate(t *testing.T) {
@@ -357,6 +516,79 @@ func TestAssetList(t *testing.T) {
 	}
 }
 
+func TestAssetLifecycle(t *testing.T) {
+	if os.Getenv("SKIP_INTEGRATION_TESTS") == "true" {
+		t.Skip("Skipping integration tests")
+	}
+
+	client := getTestClient(t)
+	ctx := context.Background()
+
+	created, err := client.Asset.Create(ctx, &asset.CreateAssetRequest{
+		AccountID: "account_id-01JG1YJ4M5J91K0J91K0J91K0J91K0J91",
+		Symbol:    "DAI",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create asset: %v", err)
+	}
+
+	refreshed, err := client.Asset.RefreshBalance(ctx, created.AssetID)
+	if err != nil {
+		t.Fatalf("Failed to refresh balance: %v", err)
+	}
+	if refreshed.LastSyncedAt.IsZero() {
+		t.Error("Expected last synced timestamp to be set")
+	}
+
+	inactive, err := client.Asset.Deactivate(ctx, created.AssetID)
+	if err != nil {
+		t.Fatalf("Failed to deactivate asset: %v", err)
+	}
+	if inactive.Status != asset.StatusInactive {
+		t.Errorf("Expected status %s, got %s", asset.StatusInactive, inactive.Status)
+	}
+
+	active, err := client.Asset.Reactivate(ctx, created.AssetID)
+	if err != nil {
+		t.Fatalf("Failed to reactivate asset: %v", err)
+	}
+	if active.Status != asset.StatusActive {
+		t.Errorf("Expected status %s, got %s", asset.StatusActive, active.Status)
+	}
+
+	if err := client.Asset.Delete(ctx, created.AssetID); err != nil {
+		t.Fatalf("Failed to delete asset: %v", err)
+	}
+
+	t.Logf("✓ Refreshed (%s), deactivated, reactivated and deleted asset: %s", refreshed.Balance, created.AssetID)
+}
+
+func TestAssetTokenRegistry(t *testing.T) {
+	if os.Getenv("SKIP_INTEGRATION_TESTS") == "true" {
+		t.Skip("Skipping integration tests")
+	}
+
+	client := getTestClient(t)
+	ctx := context.Background()
+
+	registry := asset.NewRegistry(client.Asset, 10*time.Minute)
+
+	tokens, err := registry.Tokens(ctx, "ETH", "mainnet")
+	if err != nil {
+		t.Fatalf("Failed to list tokens: %v", err)
+	}
+
+	token, err := registry.ByContract(ctx, "ETH", "mainnet", "0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48")
+	if err != nil {
+		t.Fatalf("Failed to find USDC by contract: %v", err)
+	}
+	if token.Decimals != 6 {
+		t.Errorf("Expected 6 decimals for %s, got %d", token.Symbol, token.Decimals)
+	}
+
+	t.Logf("✓ Found %d tokens, USDC resolved to %s", len(tokens), token.ContractAddress)
+}
+
 // ============ Transaction Tests ============
 
 func TestTransactionGet(t *testing.T) {
@@ -377,6 +609,77 @@ func TestTransactionGet(t *testing.T) {
 	t.Logf("✓ Transaction Get API tested (expected error: %v)", err)
 }
 
+func TestTransactionGetByHash(t *testing.T) {
+	if os.Getenv("SKIP_INTEGRATION_TESTS") == "true" {
+		t.Skip("Skipping integration tests")
+	}
+
+	client := getTestClient(t)
+	ctx := context.Background()
+
+	resp, err := client.Transaction.List(ctx, &transaction.ListTransactionsRequest{
+		Page:     1,
+		PageSize: 1,
+	})
+	if err != nil {
+		t.Fatalf("Failed to list transactions: %v", err)
+	}
+	if len(resp.Items) == 0 || resp.Items[0].TxHash == "" {
+		t.Skip("No broadcast transaction available")
+	}
+	tx := resp.Items[0]
+
+	matches, err := client.Transaction.GetByHash(ctx, tx.Chain, tx.Network, strings.ToUpper(tx.TxHash))
+	if err != nil {
+		t.Fatalf("Failed to get transactions by hash: %v", err)
+	}
+
+	found := false
+	for _, m := range matches {
+		if m.TxID == tx.TxID {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Expected %s among %d transactions for hash %s", tx.TxID, len(matches), tx.TxHash)
+	}
+
+	t.Logf("✓ Found %d transactions for hash %s", len(matches), tx.TxHash)
+}
+
+func TestTransactionSpeedUp(t *testing.T) {
+	if os.Getenv("SKIP_INTEGRATION_TESTS") == "true" {
+		t.Skip("Skipping integration tests")
+	}
+
+	client := getTestClient(t)
+	ctx := context.Background()
+
+	resp, err := client.Transaction.List(ctx, &transaction.ListTransactionsRequest{
+		Status:   transaction.StatusPending,
+		TxType:   "SEND",
+		Page:     1,
+		PageSize: 1,
+	})
+	if err != nil {
+		t.Fatalf("Failed to list transactions: %v", err)
+	}
+	if len(resp.Items) == 0 {
+		t.Skip("No pending transaction available")
+	}
+	original := resp.Items[0]
+
+	replacement, err := client.Transaction.SpeedUp(ctx, original.TxID, &transaction.ReplaceTransactionRequest{})
+	if err != nil {
+		t.Fatalf("Failed to speed up transaction: %v", err)
+	}
+	if replacement.ReplacesTxID != original.TxID {
+		t.Errorf("Expected replacement to link to %s, got %s", original.TxID, replacement.ReplacesTxID)
+	}
+
+	t.Logf("✓ Replaced %s with %s", original.TxID, replacement.TxID)
+}
+
 func TestTransactionList(t *testing.T) {
 	if os.Getenv("SKIP_INTEGRATION_TESTS") == "true" {
 		t.Skip("Skipping integration tests")
@@ -433,6 +736,94 @@ func TestTransactionListByWallet(t *testing.T) {
 	t.Logf("✓ Found %d transactions for wallet %s", len(resp.Items), w.WalletID)
 }
 
+func TestTransactionListFiltered(t *testing.T) {
+	if os.Getenv("SKIP_INTEGRATION_TESTS") == "true" {
+		t.Skip("Skipping integration tests")
+	}
+
+	client := getTestClient(t)
+	ctx := context.Background()
+
+	minAmount := amount.MustParse("0.01")
+	resp, err := client.Transaction.List(ctx, &transaction.ListTransactionsRequest{
+		TxType:      "RECEIVE",
+		Chain:       "ETH",
+		MinAmount:   &minAmount,
+		CreatedFrom: time.Now().AddDate(0, -1, 0),
+		SortOrder:   transaction.SortDesc,
+		Page:        1,
+		PageSize:    20,
+	})
+	if err != nil {
+		t.Fatalf("Failed to list transactions: %v", err)
+	}
+
+	for _, tx := range resp.Items {
+		if tx.TxType != "RECEIVE" {
+			t.Errorf("Expected RECEIVE transaction, got %s", tx.TxType)
+		}
+		if tx.Amount.Cmp(minAmount) < 0 {
+			t.Errorf("Expected amount >= %s, got %s", minAmount, tx.Amount)
+		}
+	}
+
+	t.Logf("✓ Found %d filtered transactions", len(resp.Items))
+}
+
+func TestTransactionBatch(t *testing.T) {
+	if os.Getenv("SKIP_INTEGRATION_TESTS") == "true" {
+		t.Skip("Skipping integration tests")
+	}
+
+	client := getTestClient(t)
+	ctx := context.Background()
+
+	batch, err := client.Transaction.CreateBatch(ctx, &transaction.CreateBatchTransferRequest{
+		AccountID: "account_id-01JG1YJ4M5J91K0J91K0J91K0J91K0J91",
+		AssetID:   "asset_id-01JG1YJ4M5J91K0J91K0J91K0J91K0J91",
+		Chain:     "ETH",
+		Network:   "testnet",
+		Lines: []transaction.BatchTransferLine{
+			{ToAddress: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", Amount: amount.MustParse("0.001"), IdempotencyKey: "payroll-1"},
+			{ToAddress: "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359", Amount: amount.MustParse("0.002"), IdempotencyKey: "payroll-2"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create batch: %v", err)
+	}
+	if len(batch.Lines) != 2 {
+		t.Errorf("Expected 2 lines, got %d", len(batch.Lines))
+	}
+
+	got, err := client.Transaction.GetBatch(ctx, batch.BatchID)
+	if err != nil {
+		t.Fatalf("Failed to get batch: %v", err)
+	}
+
+	t.Logf("✓ Created batch %s (%s, %s)", got.BatchID, got.Mode, got.Status)
+}
+
+// ============ Signing Tests ============
+
+func TestSignMessage(t *testing.T) {
+	if os.Getenv("SKIP_INTEGRATION_TESTS") == "true" {
+		t.Skip("Skipping integration tests")
+	}
+
+	client := getTestClient(t)
+	ctx := context.Background()
+
+	sig, err := client.Signing.SignMessage(ctx, "account_id-01JG1YJ4M5J91K0J91K0J91K0J91K0J91", []byte("paratro sdk test"))
+	if err != nil {
+		t.Fatalf("Failed to sign message: %v", err)
+	}
+	if err := sig.Verify(sig.Address); err != nil {
+		t.Errorf("Signature does not verify: %v", err)
+	}
+
+	t.Logf("✓ Signed message as %s", sig.Address)
+}
+
 // ============ Authentication Tests ============
 
 func TestLogout(t *testing.T) {
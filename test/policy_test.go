@@ -0,0 +1,150 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/paratro/paratro-sdk-go/amount"
+	"github.com/paratro/paratro-sdk-go/transaction"
+	"github.com/paratro/paratro-sdk-go/wallet"
+)
+
+func TestPolicyEvaluate(t *testing.T) {
+	limit := amount.MustParse("1000")
+	policies := []*wallet.Policy{
+		{
+			PolicyID: "treasury",
+			Enabled:  true,
+			Rules: []wallet.PolicyRule{
+				{RuleID: "limit", Type: wallet.RuleDailyLimit, AssetID: "usdt", Limit: &limit},
+				{RuleID: "allow", Type: wallet.RuleAllowlist, Addresses: []string{"0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"}},
+				{RuleID: "velocity", Type: wallet.RuleVelocity, MaxCount: 3, WindowSeconds: 3600},
+				{RuleID: "hours", Type: wallet.RuleTimeWindow, Days: []string{"MON", "TUE", "WED", "THU", "FRI"}, StartTime: "09:00", EndTime: "17:00"},
+			},
+		},
+		{
+			PolicyID: "disabled",
+			Rules:    []wallet.PolicyRule{{Type: wallet.RuleAllowlist, Addresses: []string{"0x0000000000000000000000000000000000000001"}}},
+		},
+	}
+
+	// Wednesday 2024-01-10 12:00 UTC
+	now := time.Date(2024, 1, 10, 12, 0, 0, 0, time.UTC)
+	recent := []*transaction.Transaction{
+		{TxID: "1", AssetID: "usdt", TxType: transaction.TxTypeSend, Status: transaction.StatusConfirmed, Amount: amount.MustParse("600"), CreatedAt: mustTime("2024-01-10T08:00:00Z")},
+		{TxID: "2", AssetID: "usdt", TxType: transaction.TxTypeSend, Status: transaction.StatusFailed, Amount: amount.MustParse("900"), CreatedAt: mustTime("2024-01-10T09:00:00Z")},
+		{TxID: "3", AssetID: "usdt", TxType: transaction.TxTypeSend, Status: transaction.StatusConfirmed, Amount: amount.MustParse("500"), CreatedAt: mustTime("2024-01-09T23:00:00Z")},
+		{TxID: "4", AssetID: "usdt", TxType: transaction.TxTypeReceive, Status: transaction.StatusConfirmed, Amount: amount.MustParse("5000"), CreatedAt: mustTime("2024-01-10T10:00:00Z")},
+	}
+
+	transfer := &wallet.ProspectiveTransfer{
+		AssetID:   "usdt",
+		ToAddress: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		Chain:     "ETH",
+		Amount:    amount.MustParse("400"),
+		Time:      now,
+	}
+	eval, err := wallet.Evaluate(policies, transfer, recent)
+	if err != nil {
+		t.Fatalf("Evaluate: %v", err)
+	}
+	if !eval.Allowed() {
+		t.Errorf("Expected transfer to be allowed, got %+v", eval.Violations)
+	}
+
+	cases := []struct {
+		name   string
+		modify func(tr *wallet.ProspectiveTransfer)
+		recent []*transaction.Transaction
+		rule   string
+	}{
+		{"over daily limit", func(tr *wallet.ProspectiveTransfer) { tr.Amount = amount.MustParse("400.01") }, recent, "limit"},
+		{"not allowlisted", func(tr *wallet.ProspectiveTransfer) { tr.ToAddress = "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359" }, recent, "allow"},
+		{"weekend", func(tr *wallet.ProspectiveTransfer) { tr.Time = time.Date(2024, 1, 13, 12, 0, 0, 0, time.UTC) }, nil, "hours"},
+		{"after hours", func(tr *wallet.ProspectiveTransfer) { tr.Time = time.Date(2024, 1, 10, 17, 0, 0, 0, time.UTC) }, nil, "hours"},
+		{"velocity", func(tr *wallet.ProspectiveTransfer) { tr.AssetID = "eth" }, []*transaction.Transaction{
+			{TxID: "a", TxType: transaction.TxTypeSend, Status: transaction.StatusPending, CreatedAt: mustTime("2024-01-10T11:10:00Z")},
+			{TxID: "b", TxType: transaction.TxTypeSend, Status: transaction.StatusConfirmed, CreatedAt: mustTime("2024-01-10T11:20:00Z")},
+			{TxID: "c", TxType: transaction.TxTypeSend, Status: transaction.StatusConfirmed, CreatedAt: mustTime("2024-01-10T11:30:00Z")},
+		}, "velocity"},
+	}
+	for _, tc := range cases {
+		tr := *transfer
+		tc.modify(&tr)
+		eval, err := wallet.Evaluate(policies, &tr, tc.recent)
+		if err != nil {
+			t.Fatalf("%s: Evaluate: %v", tc.name, err)
+		}
+		if len(eval.Violations) != 1 || eval.Violations[0].RuleID != tc.rule {
+			t.Errorf("%s: expected a %s violation, got %+v", tc.name, tc.rule, eval.Violations)
+		}
+	}
+}
+
+func TestPolicyLookback(t *testing.T) {
+	now := time.Date(2024, 1, 10, 12, 0, 0, 0, time.UTC)
+	policies := []*wallet.Policy{{Rules: []wallet.PolicyRule{{Type: wallet.RuleVelocity, MaxCount: 10, WindowSeconds: 7 * 24 * 3600}}}}
+	if got := wallet.Lookback(policies, now); !got.Equal(now.Add(-7 * 24 * time.Hour)) {
+		t.Errorf("Expected a 7 day lookback, got %s", got)
+	}
+	if got := wallet.Lookback(nil, now); !got.Equal(now.Add(-24 * time.Hour)) {
+		t.Errorf("Expected a 24 hour lookback, got %s", got)
+	}
+}
+
+func TestCreatePolicyValidatesRules(t *testing.T) {
+	client := getOfflineClient(t)
+
+	_, err := client.Wallet.CreatePolicy(context.Background(), "wallet_id", &wallet.CreatePolicyRequest{
+		Name:  "business hours",
+		Rules: []wallet.PolicyRule{{Type: wallet.RuleTimeWindow, StartTime: "09:00", EndTime: "09:00"}},
+	})
+	if err == nil {
+		t.Error("Expected an empty time window to be rejected")
+	}
+}
+
+func TestPolicyOvernightTimeWindow(t *testing.T) {
+	policies := []*wallet.Policy{{
+		Enabled: true,
+		Rules: []wallet.PolicyRule{
+			{RuleID: "night", Type: wallet.RuleTimeWindow, Days: []string{"FRI"}, StartTime: "22:00", EndTime: "06:00"},
+		},
+	}}
+
+	api := newFakeAPI(t)
+	api.handle("/api/v1/wallets/wallet_id/policies", func(caller string, r *http.Request) (interface{}, string) {
+		return &wallet.Policy{PolicyID: "night", Enabled: true}, ""
+	})
+	_, err := api.client(t, "key").Wallet.CreatePolicy(context.Background(), "wallet_id", &wallet.CreatePolicyRequest{
+		Name:  "overnight",
+		Rules: policies[0].Rules,
+	})
+	if err != nil {
+		t.Fatalf("Expected an overnight window to be accepted: %v", err)
+	}
+
+	cases := []struct {
+		name    string
+		time    time.Time
+		allowed bool
+	}{
+		{"friday evening", time.Date(2024, 1, 12, 22, 0, 0, 0, time.UTC), true},
+		{"saturday early morning", time.Date(2024, 1, 13, 5, 59, 0, 0, time.UTC), true},
+		{"saturday end", time.Date(2024, 1, 13, 6, 0, 0, 0, time.UTC), false},
+		{"friday afternoon", time.Date(2024, 1, 12, 15, 0, 0, 0, time.UTC), false},
+		{"friday early morning", time.Date(2024, 1, 12, 2, 0, 0, 0, time.UTC), false},
+		{"saturday evening", time.Date(2024, 1, 13, 23, 0, 0, 0, time.UTC), false},
+	}
+	for _, tc := range cases {
+		eval, err := wallet.Evaluate(policies, &wallet.ProspectiveTransfer{Amount: amount.MustParse("1"), Time: tc.time}, nil)
+		if err != nil {
+			t.Fatalf("%s: Evaluate: %v", tc.name, err)
+		}
+		if eval.Allowed() != tc.allowed {
+			t.Errorf("%s: expected allowed=%v, got %+v", tc.name, tc.allowed, eval.Violations)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/paratro/paratro-sdk-go/abi"
+	"github.com/paratro/paratro-sdk-go/signing"
+)
+
+func mustHex(t *testing.T, s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatalf("bad hex %q: %v", s, err)
+	}
+	return b
+}
+
+func TestSigningVerifyMessage(t *testing.T) {
+	// Signed with private key 0x4c0883a6...3f362318 (web3.js documentation vector)
+	signer := "0x2c7536E3605D9C16a7a3D7b1898e529396a65c23"
+	sig := mustHex(t, "b91467e570a6466aa9e9876cbcd013baba02900b8979d43fe208a4a4f339f5fd"+
+		"6007e74cd82e037b800186422fc2da167c747ef045e5d18a5f5d4300f8e1a029"+"1c")
+
+	digest := signing.HashMessage([]byte("Some data"))
+	if got := hex.EncodeToString(digest[:]); got != "1da44b586eb0729ff70a73c326926f6ed5a25f5b056e7f47fbc6e58d86871655" {
+		t.Errorf("Unexpected message hash %s", got)
+	}
+
+	recovered, err := signing.RecoverAddress(digest[:], sig)
+	if err != nil {
+		t.Fatalf("RecoverAddress: %v", err)
+	}
+	if recovered != signer {
+		t.Errorf("Expected signer %s, got %s", signer, recovered)
+	}
+
+	if err := signing.VerifyMessage(signer, []byte("Some data"), sig); err != nil {
+		t.Errorf("VerifyMessage: %v", err)
+	}
+	if err := signing.VerifyMessage(signer, []byte("Other data"), sig); !errors.Is(err, signing.ErrSignerMismatch) {
+		t.Errorf("Expected ErrSignerMismatch, got %v", err)
+	}
+}
+
+func TestSigningVerifyTypedData(t *testing.T) {
+	// Example from the EIP-712 specification
+	td := &signing.TypedData{
+		Types: map[string][]signing.TypedDataField{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+				{Name: "verifyingContract", Type: "address"},
+			},
+			"Person": {
+				{Name: "name", Type: "string"},
+				{Name: "wallet", Type: "address"},
+			},
+			"Mail": {
+				{Name: "from", Type: "Person"},
+				{Name: "to", Type: "Person"},
+				{Name: "contents", Type: "string"},
+			},
+		},
+		PrimaryType: "Mail",
+		Domain: map[string]interface{}{
+			"name":              "Ether Mail",
+			"version":           "1",
+			"chainId":           float64(1),
+			"verifyingContract": "0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC",
+		},
+		Message: map[string]interface{}{
+			"from": map[string]interface{}{
+				"name":   "Cow",
+				"wallet": "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826",
+			},
+			"to": map[string]interface{}{
+				"name":   "Bob",
+				"wallet": "0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB",
+			},
+			"contents": "Hello, Bob!",
+		},
+	}
+
+	digest, err := signing.HashTypedData(td)
+	if err != nil {
+		t.Fatalf("HashTypedData: %v", err)
+	}
+	if got := hex.EncodeToString(digest[:]); got != "be609aee343fb3c4b28e1df9e632fca64fcfaede20f02e86244efddf30957bd2" {
+		t.Errorf("Unexpected typed data hash %s", got)
+	}
+
+	sig := mustHex(t, "4355c47d63924e8a72e509b65029052eb6c299d53a04e167c5775fd466751c9d"+
+		"07299936d304c153f6443dfa05f40ff007d72911b6f72307f996231605b91562"+"1c")
+	if err := signing.VerifyTypedData("0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826", td, sig); err != nil {
+		t.Errorf("VerifyTypedData: %v", err)
+	}
+}
+
+func TestTypedDataKeepsLargeUint256Precision(t *testing.T) {
+	const value = "115792089237316195423570985008687907853269984665640564039457584007913129639935"
+	raw := `{
+		"types": {
+			"Permit": [{"name": "value", "type": "uint256"}, {"name": "deadline", "type": "uint256"}]
+		},
+		"primaryType": "Permit",
+		"domain": {"name": "Token", "chainId": 1},
+		"message": {"value": ` + value + `, "deadline": 9007199254740993}
+	}`
+
+	var decoded signing.TypedData
+	if err := json.Unmarshal([]byte(raw), &decoded); err != nil {
+		t.Fatalf("Failed to decode typed data: %v", err)
+	}
+	got, err := signing.HashTypedData(&decoded)
+	if err != nil {
+		t.Fatalf("HashTypedData: %v", err)
+	}
+
+	want, err := signing.HashTypedData(&signing.TypedData{
+		Types:       decoded.Types,
+		PrimaryType: "Permit",
+		Domain:      map[string]interface{}{"name": "Token", "chainId": "1"},
+		Message:     map[string]interface{}{"value": value, "deadline": "9007199254740993"},
+	})
+	if err != nil {
+		t.Fatalf("HashTypedData: %v", err)
+	}
+	if got != want {
+		t.Errorf("Expected decoded typed data to hash to %x, got %x", want, got)
+	}
+
+	// A float64 above 2^53 has already lost precision and must be rejected
+	_, err = signing.HashTypedData(&signing.TypedData{
+		Types:       decoded.Types,
+		PrimaryType: "Permit",
+		Domain:      map[string]interface{}{"name": "Token"},
+		Message:     map[string]interface{}{"value": float64(1e21), "deadline": float64(1)},
+	})
+	if !errors.Is(err, abi.ErrInvalidArgument) {
+		t.Errorf("Expected ErrInvalidArgument for a float64 above 2^53, got %v", err)
+	}
+}
@@ -0,0 +1,72 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/paratro/paratro-sdk-go/amount"
+	"github.com/paratro/paratro-sdk-go/asset"
+	"github.com/paratro/paratro-sdk-go/common"
+	"github.com/paratro/paratro-sdk-go/snapshot"
+)
+
+func TestSnapshotStores(t *testing.T) {
+	stores := map[string]snapshot.Store{
+		"memory":    snapshot.NewMemoryStore(),
+		"jsonlines": snapshot.NewJSONLinesStore(filepath.Join(t.TempDir(), "balances.jsonl")),
+	}
+
+	base := time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)
+	snapshots := []*asset.BalanceSnapshot{
+		{AssetID: "asset_a", Balance: amount.MustParse("1"), Timestamp: common.NewTime(base)},
+		{AssetID: "asset_a", Balance: amount.MustParse("2.5"), Timestamp: common.NewTime(base.Add(24 * time.Hour))},
+		{AssetID: "asset_b", Balance: amount.MustParse("9"), Timestamp: common.NewTime(base)},
+	}
+
+	for name, store := range stores {
+		ctx := context.Background()
+		if err := store.Save(ctx, snapshots); err != nil {
+			t.Fatalf("%s: Save: %v", name, err)
+		}
+
+		snap, err := store.BalanceAt(ctx, "asset_a", base.Add(12*time.Hour))
+		if err != nil {
+			t.Fatalf("%s: BalanceAt: %v", name, err)
+		}
+		if snap.Balance.String() != "1" {
+			t.Errorf("%s: expected month-end balance 1, got %s", name, snap.Balance)
+		}
+
+		if _, err := store.BalanceAt(ctx, "asset_a", base.Add(-time.Hour)); !errors.Is(err, snapshot.ErrNoSnapshot) {
+			t.Errorf("%s: expected ErrNoSnapshot, got %v", name, err)
+		}
+
+		series, err := store.Range(ctx, "asset_a", base, base.Add(48*time.Hour))
+		if err != nil {
+			t.Fatalf("%s: Range: %v", name, err)
+		}
+		if len(series) != 2 || series[1].Balance.String() != "2.5" {
+			t.Errorf("%s: unexpected series %v", name, series)
+		}
+	}
+}
+
+func TestSnapshotStoreReadsUnixTimestamps(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "balances.jsonl")
+	line := `{"asset_id":"asset_a","balance":"4","timestamp":1769817600}` + "\n"
+	if err := os.WriteFile(path, []byte(line), 0o644); err != nil {
+		t.Fatalf("Failed to write snapshot file: %v", err)
+	}
+
+	snap, err := snapshot.NewJSONLinesStore(path).BalanceAt(context.Background(), "asset_a", time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("BalanceAt: %v", err)
+	}
+	if snap.Balance.String() != "4" || snap.Timestamp.Unix() != 1769817600 {
+		t.Errorf("Expected balance 4 at 1769817600, got %s at %v", snap.Balance, snap.Timestamp)
+	}
+}
@@ -0,0 +1,166 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/paratro/paratro-sdk-go/account"
+	"github.com/paratro/paratro-sdk-go/amount"
+	"github.com/paratro/paratro-sdk-go/asset"
+	"github.com/paratro/paratro-sdk-go/sweep"
+	"github.com/paratro/paratro-sdk-go/transaction"
+)
+
+// fakeWallet serves the accounts and assets of one wallet and records transfers
+type fakeWallet struct {
+	mu        sync.Mutex
+	accounts  []account.Account
+	assets    []*asset.Asset
+	transfers []transaction.CreateTransferRequest
+}
+
+func newFakeWallet(api *fakeAPI, accounts []account.Account, assets []*asset.Asset) *fakeWallet {
+	f := &fakeWallet{accounts: accounts, assets: assets}
+
+	api.handle("/api/v1/accounts", func(caller string, r *http.Request) (interface{}, string) {
+		return &account.ListAccountsResponse{Items: f.accounts}, ""
+	})
+	api.handle("/api/v1/accounts/", func(caller string, r *http.Request) (interface{}, string) {
+		id := strings.TrimPrefix(r.URL.Path, "/api/v1/accounts/")
+		for i := range f.accounts {
+			if f.accounts[i].AccountID == id {
+				return &f.accounts[i], ""
+			}
+		}
+		return nil, "account not found"
+	})
+	api.handle("/api/v1/assets", func(caller string, r *http.Request) (interface{}, string) {
+		return f.assets, ""
+	})
+	api.handle("/api/v1/transactions", func(caller string, r *http.Request) (interface{}, string) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		var req transaction.CreateTransferRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		f.transfers = append(f.transfers, req)
+		return &transaction.Transaction{TxID: req.IdempotencyKey, Status: transaction.StatusPending}, ""
+	})
+	api.handle("/api/v1/transactions/", func(caller string, r *http.Request) (interface{}, string) {
+		return &transaction.Transaction{TxID: strings.TrimPrefix(r.URL.Path, "/api/v1/transactions/"), Status: transaction.StatusConfirmed}, ""
+	})
+	return f
+}
+
+func ethAccount(id, addr string) account.Account {
+	return account.Account{AccountID: id, Address: addr, Chain: "ETH", Network: "mainnet", Status: account.StatusActive}
+}
+
+func TestSweepPlanAndExecute(t *testing.T) {
+	api := newFakeAPI(t)
+	wallet := newFakeWallet(api,
+		[]account.Account{
+			ethAccount("hot", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"),
+			ethAccount("dep-a", "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359"),
+			ethAccount("dep-b", "0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB"),
+			ethAccount("dep-c", "0xD1220A0cf47c7B9Be7A2E6BA89F429762e7b9aDb"),
+			{AccountID: "dep-trx", Address: "TD5gsCwxykWsLN9aPrq2TAfNjByuZKYp4E", Chain: "TRX", Network: "mainnet", Status: account.StatusActive},
+			{AccountID: "dep-testnet", Address: "0x8617E340B3D01FA5F11F306F4090FD50E238070D", Chain: "ETH", Network: "testnet", Status: account.StatusActive},
+		},
+		[]*asset.Asset{
+			{AssetID: "hot-eth", AccountID: "hot", Symbol: "ETH", AssetType: asset.AssetTypeNative, Balance: amount.MustParse("1")},
+			{AssetID: "a-usdt", AccountID: "dep-a", Symbol: "USDT", AssetType: asset.AssetTypeERC20,
+				ContractAddress: "0xdAC17F958D2ee523a2206206994597C13D831ec7", Balance: amount.MustParse("500")},
+			{AssetID: "a-eth", AccountID: "dep-a", Symbol: "ETH", AssetType: asset.AssetTypeNative, Balance: amount.MustParse("0.0005")},
+			{AssetID: "b-eth", AccountID: "dep-b", Symbol: "ETH", AssetType: asset.AssetTypeNative, Balance: amount.MustParse("0.5")},
+			// A bridged token sharing the USDT symbol has no threshold of its own
+			{AssetID: "b-usdt", AccountID: "dep-b", Symbol: "USDT", AssetType: asset.AssetTypeERC20,
+				ContractAddress: "0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB", Balance: amount.MustParse("500")},
+			{AssetID: "c-usdt", AccountID: "dep-c", Symbol: "USDT", AssetType: asset.AssetTypeERC20,
+				ContractAddress: "0xdAC17F958D2ee523a2206206994597C13D831ec7", Balance: amount.MustParse("50")},
+			// Other chains and networks are never swept to the ETH mainnet target
+			{AssetID: "trx-usdt", AccountID: "dep-trx", Symbol: "USDT", AssetType: asset.AssetTypeTRC20, Balance: amount.MustParse("500")},
+			{AssetID: "trx-trx", AccountID: "dep-trx", Symbol: "TRX", AssetType: asset.AssetTypeNative, Balance: amount.MustParse("5000")},
+			{AssetID: "testnet-eth", AccountID: "dep-testnet", Symbol: "ETH", AssetType: asset.AssetTypeNative, Balance: amount.MustParse("5")},
+		},
+	)
+
+	client := api.client(t, "key")
+	sweeper := sweep.NewSweeper(client.Account, client.Asset, client.Transaction)
+	sweeper.WalletID = "wallet-1"
+	sweeper.TargetAccountID = "hot"
+	sweeper.Thresholds = map[string]amount.Amount{
+		"0xdac17f958d2ee523a2206206994597c13d831ec7": amount.MustParse("100"),
+		"eth": amount.MustParse("0.1"),
+	}
+	sweeper.GasPerTransfer = amount.MustParse("0.002")
+	sweeper.GasWaitInterval = time.Millisecond
+
+	ctx := context.Background()
+	plan, err := sweeper.Plan(ctx)
+	if err != nil {
+		t.Fatalf("Plan: %v", err)
+	}
+	if len(plan.Items) != 2 {
+		t.Fatalf("Expected 2 planned sweeps, got %d", len(plan.Items))
+	}
+	usdt, eth := plan.Items[0], plan.Items[1]
+	if usdt.Asset.AssetID != "a-usdt" || usdt.GasTopUp == nil || !usdt.GasTopUp.Equal(amount.MustParse("0.0015")) {
+		t.Errorf("Expected a USDT sweep with a 0.0015 ETH top-up, got %+v", usdt)
+	}
+	if eth.Asset.AssetID != "b-eth" || eth.GasTopUp != nil || !eth.Amount.Equal(amount.MustParse("0.498")) {
+		t.Errorf("Expected a 0.498 ETH sweep, got %+v", eth)
+	}
+	if len(wallet.transfers) != 0 {
+		t.Fatalf("Plan must not submit transfers")
+	}
+
+	report, err := sweeper.Execute(ctx, plan)
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if len(report.Failed()) != 0 {
+		t.Fatalf("Unexpected failures: %+v", report.Failed()[0].Err)
+	}
+	if len(wallet.transfers) != 3 {
+		t.Fatalf("Expected a top-up and two sweeps, got %+v", wallet.transfers)
+	}
+	gas := wallet.transfers[0]
+	if gas.AccountID != "hot" || gas.AssetID != "hot-eth" || gas.ToAddress != "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359" {
+		t.Errorf("Expected the top-up to be sent first from the hot account, got %+v", gas)
+	}
+	for _, tr := range wallet.transfers[1:] {
+		if tr.ToAddress != "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed" || !strings.HasPrefix(tr.IdempotencyKey, plan.ID) {
+			t.Errorf("Expected sweeps to the hot account keyed by plan, got %+v", tr)
+		}
+	}
+}
+
+func TestSweepPlanIDsAreUnique(t *testing.T) {
+	api := newFakeAPI(t)
+	newFakeWallet(api,
+		[]account.Account{ethAccount("hot", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed")},
+		[]*asset.Asset{{AssetID: "hot-eth", AccountID: "hot", Symbol: "ETH", AssetType: asset.AssetTypeNative, Balance: amount.MustParse("1")}},
+	)
+
+	client := api.client(t, "key")
+	sweeper := sweep.NewSweeper(client.Account, client.Asset, client.Transaction)
+	sweeper.WalletID = "wallet-1"
+	sweeper.TargetAccountID = "hot"
+
+	first, err := sweeper.Plan(context.Background())
+	if err != nil {
+		t.Fatalf("Plan: %v", err)
+	}
+	second, err := sweeper.Plan(context.Background())
+	if err != nil {
+		t.Fatalf("Plan: %v", err)
+	}
+	if first.ID == second.ID {
+		t.Errorf("Expected plans made in the same second to have distinct IDs, both got %s", first.ID)
+	}
+}
@@ -0,0 +1,88 @@
+package test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/paratro/paratro-sdk-go/common"
+	"github.com/paratro/paratro-sdk-go/transaction"
+)
+
+func mustTime(s string) common.Time {
+	t, err := common.ParseTime(s)
+	if err != nil {
+		panic(err)
+	}
+	return common.NewTime(t)
+}
+
+func TestTimeUnmarshalFormats(t *testing.T) {
+	want := time.Date(2024, 1, 10, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		in   string
+		want time.Time
+	}{
+		{`"2024-01-10T10:00:00Z"`, want},
+		{`"2024-01-10T12:00:00+02:00"`, want},
+		{`"2024-01-10T10:00:00.123456789Z"`, want.Add(123456789)},
+		{`1704880800`, want},
+		{`"1704880800"`, want},
+		{`1704880800.5`, want.Add(500 * time.Millisecond)},
+		{`null`, time.Time{}},
+		{`""`, time.Time{}},
+	}
+	for _, tt := range tests {
+		var got common.Time
+		if err := json.Unmarshal([]byte(tt.in), &got); err != nil {
+			t.Errorf("Unmarshal(%s): %v", tt.in, err)
+			continue
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("Unmarshal(%s) = %v, want %v", tt.in, got.Time, tt.want)
+		}
+	}
+
+	var bad common.Time
+	if err := json.Unmarshal([]byte(`"yesterday"`), &bad); err == nil {
+		t.Error("Expected an error for an unparseable timestamp")
+	}
+}
+
+func TestTimeMarshalIsLossless(t *testing.T) {
+	data := `{"tx_id":"tx-1","created_at":1704880800,"updated_at":"2024-01-10T12:00:00+02:00","confirmed_at":"2024-01-10T10:00:00.120Z"}`
+	var tx transaction.Transaction
+	if err := json.Unmarshal([]byte(data), &tx); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if !tx.CreatedAt.Equal(tx.UpdatedAt.Time) {
+		t.Errorf("Expected both formats to decode to the same instant, got %v and %v", tx.CreatedAt.Time, tx.UpdatedAt.Time)
+	}
+
+	out, err := json.Marshal(&tx)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	var fields map[string]json.RawMessage
+	json.Unmarshal(out, &fields)
+	for key, want := range map[string]string{
+		"created_at":   `1704880800`,
+		"updated_at":   `"2024-01-10T12:00:00+02:00"`,
+		"confirmed_at": `"2024-01-10T10:00:00.120Z"`,
+	} {
+		if string(fields[key]) != want {
+			t.Errorf("Expected %s to marshal back as %s, got %s", key, want, fields[key])
+		}
+	}
+
+	// A modified time is re-encoded
+	tx.CreatedAt.Time = tx.CreatedAt.Add(time.Second)
+	out, _ = json.Marshal(tx.CreatedAt)
+	if string(out) != `"2024-01-10T10:00:01Z"` {
+		t.Errorf("Expected a modified time to marshal as RFC3339, got %s", out)
+	}
+	out, _ = json.Marshal(common.Time{})
+	if string(out) != `null` {
+		t.Errorf("Expected the zero time to marshal as null, got %s", out)
+	}
+}
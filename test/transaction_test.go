@@ -0,0 +1,366 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	mpcsdk "github.com/paratro/paratro-sdk-go"
+	"github.com/paratro/paratro-sdk-go/amount"
+	"github.com/paratro/paratro-sdk-go/common"
+	"github.com/paratro/paratro-sdk-go/configuration"
+	"github.com/paratro/paratro-sdk-go/transaction"
+)
+
+func getOfflineClient(t *testing.T) *mpcsdk.Client {
+	client, err := mpcsdk.NewClient("key", "secret", configuration.Custom("http://127.0.0.1:0"))
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	return client
+}
+
+func TestCreateBatchRejectsDuplicateKeys(t *testing.T) {
+	client := getOfflineClient(t)
+
+	line := transaction.BatchTransferLine{
+		ToAddress:      "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		Amount:         amount.MustParse("1"),
+		IdempotencyKey: "payout-1",
+	}
+	_, err := client.Transaction.CreateBatch(context.Background(), &transaction.CreateBatchTransferRequest{
+		AccountID: "account_id",
+		AssetID:   "asset_id",
+		Chain:     "ETH",
+		Lines:     []transaction.BatchTransferLine{line, line},
+	})
+	if err == nil || !strings.Contains(err.Error(), "duplicate idempotency key") {
+		t.Errorf("Expected duplicate key error, got %v", err)
+	}
+}
+
+func TestTransferValidatesExtras(t *testing.T) {
+	client := getOfflineClient(t)
+	ctx := context.Background()
+	tag := uint32(12345)
+
+	cases := []struct {
+		name string
+		req  transaction.CreateTransferRequest
+		want error
+	}{
+		{"memo on EVM", transaction.CreateTransferRequest{Chain: "ETH", ToAddress: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", Memo: "hello"}, transaction.ErrInvalidExtras},
+		{"tag on TRX", transaction.CreateTransferRequest{Chain: "TRX", ToAddress: "TBXSw8fM4jpQkGc6zZjsVABFpVN7UvXPdV", Extras: &transaction.Extras{DestinationTag: &tag}}, transaction.ErrInvalidExtras},
+		{"long TRX memo", transaction.CreateTransferRequest{Chain: "TRX", ToAddress: "TBXSw8fM4jpQkGc6zZjsVABFpVN7UvXPdV", Memo: strings.Repeat("x", 257)}, transaction.ErrInvalidExtras},
+		{"EVM data without 0x", transaction.CreateTransferRequest{Chain: "ETH", ToAddress: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", Extras: &transaction.Extras{Data: "abcd"}}, transaction.ErrInvalidExtras},
+		{"OP_RETURN too long", transaction.CreateTransferRequest{Chain: "BTC", ToAddress: "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", Extras: &transaction.Extras{OpReturn: strings.Repeat("00", 81)}}, transaction.ErrInvalidExtras},
+		{"XRP without tag", transaction.CreateTransferRequest{Chain: "XRP", ToAddress: "rEb8TK3gBgk5auZkwc6sHnwrGVJH8DuaLh", RequireTag: true}, transaction.ErrTagRequired},
+		{"memo conflict", transaction.CreateTransferRequest{Chain: "TRX", ToAddress: "TBXSw8fM4jpQkGc6zZjsVABFpVN7UvXPdV", Memo: "a", Extras: &transaction.Extras{Memo: "b"}}, transaction.ErrInvalidExtras},
+	}
+	for _, tc := range cases {
+		req := tc.req
+		req.AccountID = "account_id"
+		req.Amount = amount.MustParse("1")
+		if _, err := client.Transaction.Transfer(ctx, &req); !errors.Is(err, tc.want) {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.want, err)
+		}
+	}
+}
+
+func TestMemoExtras(t *testing.T) {
+	extras, err := transaction.MemoExtras("XRP", "4294967295")
+	if err != nil || extras.DestinationTag == nil || *extras.DestinationTag != 4294967295 {
+		t.Errorf("Expected an XRP destination tag, got %+v, %v", extras, err)
+	}
+	if _, err := transaction.MemoExtras("XRP", "4294967296"); !errors.Is(err, transaction.ErrInvalidExtras) {
+		t.Errorf("Expected an out of range tag to fail, got %v", err)
+	}
+	if extras, _ := transaction.MemoExtras("TRX", "customer-7"); extras.Memo != "customer-7" {
+		t.Errorf("Expected a TRX memo, got %+v", extras)
+	}
+	if err := (&transaction.Extras{OpReturn: strings.Repeat("ab", 80)}).Validate("BTC"); err != nil {
+		t.Errorf("Expected an 80 byte OP_RETURN to be valid, got %v", err)
+	}
+}
+
+func TestApproveScalesAllowanceToTokenDecimals(t *testing.T) {
+	api := newFakeAPI(t)
+	var data string
+	api.handle("/api/v1/transactions/contract-calls", func(caller string, r *http.Request) (interface{}, string) {
+		var body struct {
+			Data string `json:"data"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		data = body.Data
+		return &transaction.Transaction{TxID: "tx-1"}, ""
+	})
+	client := api.client(t, "key")
+
+	ctx := context.Background()
+	decimals18, decimals6 := 18, 6
+	_, err := client.Transaction.Approve(ctx, &transaction.ApproveRequest{
+		AccountID:    "account_id",
+		Chain:        "ETH",
+		TokenAddress: "0xdAC17F958D2ee523a2206206994597C13D831ec7",
+		Spender:      "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		Allowance:    amount.MustParse("100"),
+		Decimals:     &decimals18,
+	})
+	if err != nil {
+		t.Fatalf("Approve: %v", err)
+	}
+	// 100 * 10^18 = 0x56bc75e2d63100000
+	want := "0x095ea7b3" +
+		"0000000000000000000000005aaeb6053f3e94c9b9a09f33669435e7ef1beaed" +
+		"0000000000000000000000000000000000000000000000056bc75e2d63100000"
+	if data != want {
+		t.Errorf("Unexpected calldata:\n got %s\nwant %s", data, want)
+	}
+
+	_, err = client.Transaction.Approve(ctx, &transaction.ApproveRequest{
+		AccountID:    "account_id",
+		TokenAddress: "0xdAC17F958D2ee523a2206206994597C13D831ec7",
+		Spender:      "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		Allowance:    amount.MustParse("1.0000001"),
+		Decimals:     &decimals6,
+	})
+	if !errors.Is(err, amount.ErrPrecisionLoss) {
+		t.Errorf("Expected ErrPrecisionLoss for an allowance finer than the token, got %v", err)
+	}
+
+	data = ""
+	_, err = client.Transaction.Approve(ctx, &transaction.ApproveRequest{
+		AccountID:    "account_id",
+		TokenAddress: "0xdAC17F958D2ee523a2206206994597C13D831ec7",
+		Spender:      "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		Allowance:    amount.MustParse("100"),
+	})
+	if err == nil || data != "" {
+		t.Errorf("Expected Approve without decimals to fail before calling the API, got %v", err)
+	}
+}
+
+func TestCreateBatchValidatesLineExtras(t *testing.T) {
+	api := newFakeAPI(t)
+	var posted transaction.CreateBatchTransferRequest
+	api.handle("/api/v1/transactions/batches", func(caller string, r *http.Request) (interface{}, string) {
+		json.NewDecoder(r.Body).Decode(&posted)
+		return &transaction.Batch{BatchID: "batch-1"}, ""
+	})
+	client := api.client(t, "key")
+	ctx := context.Background()
+	tag := uint32(12345)
+
+	_, err := client.Transaction.CreateBatch(ctx, &transaction.CreateBatchTransferRequest{
+		AccountID: "account_id",
+		Chain:     "XRP",
+		Lines: []transaction.BatchTransferLine{
+			{ToAddress: "rEb8TK3gBgk5auZkwc6sHnwrGVJH8DuaLh", Amount: amount.MustParse("10"), Extras: &transaction.Extras{DestinationTag: &tag}, IdempotencyKey: "line-1"},
+			{ToAddress: "rEb8TK3gBgk5auZkwc6sHnwrGVJH8DuaLh", Amount: amount.MustParse("10"), Memo: "54321", IdempotencyKey: "line-2"},
+		},
+	})
+	if !errors.Is(err, transaction.ErrInvalidExtras) {
+		t.Fatalf("Expected a memo on XRP to be rejected, got %v", err)
+	}
+
+	_, err = client.Transaction.CreateBatch(ctx, &transaction.CreateBatchTransferRequest{
+		AccountID: "account_id",
+		Chain:     "XLM",
+		Lines: []transaction.BatchTransferLine{
+			{ToAddress: "GA7QYNF7SOWQ3GLR2BGMZEHXAVIRZA4KVWLTJJFC7MGXUA74P7UJVSGZ", Amount: amount.MustParse("10"), Memo: strings.Repeat("x", 29), IdempotencyKey: "line-1"},
+		},
+	})
+	if !errors.Is(err, transaction.ErrInvalidExtras) {
+		t.Fatalf("Expected an oversized XLM memo to be rejected locally, got %v", err)
+	}
+
+	_, err = client.Transaction.CreateBatch(ctx, &transaction.CreateBatchTransferRequest{
+		AccountID: "account_id",
+		Chain:     "XRP",
+		Lines: []transaction.BatchTransferLine{
+			{ToAddress: "rEb8TK3gBgk5auZkwc6sHnwrGVJH8DuaLh", Amount: amount.MustParse("10"), Extras: &transaction.Extras{DestinationTag: &tag}, IdempotencyKey: "line-1"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("CreateBatch: %v", err)
+	}
+	line := posted.Lines[0]
+	if line.Extras == nil || line.Extras.DestinationTag == nil || *line.Extras.DestinationTag != tag {
+		t.Errorf("Expected the destination tag to be sent, got %+v", line)
+	}
+}
+
+func TestTransferSendsMemoOnce(t *testing.T) {
+	api := newFakeAPI(t)
+	var posted map[string]json.RawMessage
+	api.handle("/api/v1/transactions", func(caller string, r *http.Request) (interface{}, string) {
+		json.NewDecoder(r.Body).Decode(&posted)
+		return &transaction.Transaction{TxID: "tx-1"}, ""
+	})
+	client := api.client(t, "key")
+
+	_, err := client.Transaction.Transfer(context.Background(), &transaction.CreateTransferRequest{
+		AccountID: "account_id",
+		AssetID:   "asset_id",
+		ToAddress: "TBXSw8fM4jpQkGc6zZjsVABFpVN7UvXPdV",
+		Amount:    amount.MustParse("1"),
+		Chain:     "TRX",
+		Memo:      "customer-7",
+	})
+	if err != nil {
+		t.Fatalf("Transfer: %v", err)
+	}
+	if _, ok := posted["memo"]; ok {
+		t.Errorf("Expected the memo to be sent only in extras, got %s", posted["memo"])
+	}
+	if string(posted["extras"]) != `{"memo":"customer-7"}` {
+		t.Errorf("Expected extras to carry the memo, got %s", posted["extras"])
+	}
+}
+
+func TestGetByHashNormalizesHexHashes(t *testing.T) {
+	api := newFakeAPI(t)
+	var queries []string
+	api.handle("/api/v1/transactions/by-hash", func(caller string, r *http.Request) (interface{}, string) {
+		q := r.URL.Query()
+		queries = append(queries, q.Get("chain")+" "+q.Get("network")+" "+q.Get("tx_hash"))
+		return []*transaction.Transaction{
+			{TxID: "tx-1", TxHash: q.Get("tx_hash")},
+			{TxID: "tx-2", TxHash: q.Get("tx_hash")},
+		}, ""
+	})
+	client := api.client(t, "key")
+	ctx := context.Background()
+
+	tests := []struct {
+		chain string
+		hash  string
+		want  string
+	}{
+		{"ETH", "0xABCDEF0123456789", "ETH mainnet 0xabcdef0123456789"},
+		{"ETH", " 0XAbCdEf0123456789\n", "ETH mainnet 0xabcdef0123456789"},
+		{"BTC", "ABCDEF0123456789", "BTC mainnet abcdef0123456789"},
+		// Base58 and other non-hex hashes are case-sensitive and kept as sent
+		{"SOL", " 5VfYmGC9L2VTBhBm6Mx4bXb8vT1sCCjm2Tz4xKfK2Tf ", "SOL mainnet 5VfYmGC9L2VTBhBm6Mx4bXb8vT1sCCjm2Tz4xKfK2Tf"},
+	}
+	for _, tt := range tests {
+		matches, err := client.Transaction.GetByHash(ctx, common.Chain(tt.chain), common.NetworkMainnet, tt.hash)
+		if err != nil {
+			t.Fatalf("Failed to get transactions by hash %q: %v", tt.hash, err)
+		}
+		if len(matches) != 2 {
+			t.Errorf("Expected 2 transactions for hash %q, got %d", tt.hash, len(matches))
+		}
+		if got := queries[len(queries)-1]; got != tt.want {
+			t.Errorf("GetByHash(%q) queried %q, want %q", tt.hash, got, tt.want)
+		}
+	}
+}
+
+func TestListTransactionsEncodesQuery(t *testing.T) {
+	api := newFakeAPI(t)
+	var query string
+	api.handle("/api/v1/transactions", func(caller string, r *http.Request) (interface{}, string) {
+		query = r.URL.RawQuery
+		return []*transaction.Transaction{}, ""
+	})
+	client := api.client(t, "key")
+	ctx := context.Background()
+
+	minAmount := amount.MustParse("0.5")
+	_, err := client.Transaction.List(ctx, &transaction.ListTransactionsRequest{
+		WalletID:    "wallet-1",
+		Status:      transaction.StatusConfirmed,
+		TxType:      transaction.TxTypeReceive,
+		Chain:       common.ChainEthereum,
+		MinAmount:   &minAmount,
+		CreatedFrom: time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC),
+		CreatedTo:   time.Date(2024, 1, 10, 23, 59, 59, 999000000, time.FixedZone("CET", 3600)),
+		SortOrder:   "DESC",
+		Page:        2,
+		PageSize:    50,
+	})
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	want := "chain=ETH" +
+		"&created_from=2024-01-10T00%3A00%3A00Z" +
+		"&created_to=2024-01-10T22%3A59%3A59.999Z" +
+		"&min_amount=0.5&page=2&page_size=50&sort_order=desc" +
+		"&status=CONFIRMED&tx_type=RECEIVE&wallet_id=wallet-1"
+	if query != want {
+		t.Errorf("Unexpected query:\n got %s\nwant %s", query, want)
+	}
+
+	query = ""
+	if _, err := client.Transaction.List(ctx, &transaction.ListTransactionsRequest{SortOrder: "newest"}); !errors.Is(err, common.ErrUnknownValue) {
+		t.Errorf("Expected ErrUnknownValue for an invalid sort order, got %v", err)
+	}
+	if query != "" {
+		t.Errorf("Expected an invalid sort order to fail before calling the API, got query %s", query)
+	}
+}
+
+func TestSpeedUpAndCancelReplaceTransactions(t *testing.T) {
+	api := newFakeAPI(t)
+	var calls []string
+	api.handle("/api/v1/transactions/", func(caller string, r *http.Request) (interface{}, string) {
+		body, _ := io.ReadAll(r.Body)
+		calls = append(calls, r.Method+" "+r.URL.Path+" "+strings.TrimSpace(string(body)))
+		switch r.URL.Path {
+		case "/api/v1/transactions/tx-1/speed-up":
+			return &transaction.Transaction{TxID: "tx-2", ReplacesTxID: "tx-1", Status: transaction.StatusPending}, ""
+		case "/api/v1/transactions/tx-1/cancel":
+			return &transaction.Transaction{TxID: "tx-3", ReplacesTxID: "tx-1", Status: transaction.StatusPending}, ""
+		}
+		return nil, "transaction is not pending"
+	})
+	client := api.client(t, "key")
+	ctx := context.Background()
+
+	replacement, err := client.Transaction.SpeedUp(ctx, "tx-1", &transaction.ReplaceTransactionRequest{
+		MaxFeePerGas:         "40000000000",
+		MaxPriorityFeePerGas: "2000000000",
+	})
+	if err != nil {
+		t.Fatalf("SpeedUp: %v", err)
+	}
+	if replacement.TxID != "tx-2" || replacement.ReplacesTxID != "tx-1" {
+		t.Errorf("Expected tx-2 to replace tx-1, got %s replacing %s", replacement.TxID, replacement.ReplacesTxID)
+	}
+
+	cancel, err := client.Transaction.Cancel(ctx, "tx-1", &transaction.ReplaceTransactionRequest{FeeRate: "25"})
+	if err != nil {
+		t.Fatalf("Cancel: %v", err)
+	}
+	if cancel.TxID != "tx-3" || cancel.ReplacesTxID != "tx-1" {
+		t.Errorf("Expected tx-3 to replace tx-1, got %s replacing %s", cancel.TxID, cancel.ReplacesTxID)
+	}
+
+	if _, err := client.Transaction.SpeedUp(ctx, "tx-confirmed", &transaction.ReplaceTransactionRequest{}); err == nil || !strings.Contains(err.Error(), "not pending") {
+		t.Errorf("Expected speeding up a confirmed transaction to fail, got %v", err)
+	}
+	if _, err := client.Transaction.Cancel(ctx, "tx-confirmed", &transaction.ReplaceTransactionRequest{}); err == nil || !strings.Contains(err.Error(), "not pending") {
+		t.Errorf("Expected cancelling a confirmed transaction to fail, got %v", err)
+	}
+
+	want := []string{
+		`POST /api/v1/transactions/tx-1/speed-up {"max_fee_per_gas":"40000000000","max_priority_fee_per_gas":"2000000000"}`,
+		`POST /api/v1/transactions/tx-1/cancel {"fee_rate":"25"}`,
+		`POST /api/v1/transactions/tx-confirmed/speed-up {}`,
+		`POST /api/v1/transactions/tx-confirmed/cancel {}`,
+	}
+	if len(calls) != len(want) {
+		t.Fatalf("Expected calls %v, got %v", want, calls)
+	}
+	for i := range want {
+		if calls[i] != want[i] {
+			t.Errorf("Expected call %d to be %s, got %s", i, want[i], calls[i])
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/paratro/paratro-sdk-go/amount"
+	"github.com/paratro/paratro-sdk-go/transaction"
+	"github.com/paratro/paratro-sdk-go/utxo"
+)
+
+func testUTXOs() []*utxo.UTXO {
+	return []*utxo.UTXO{
+		{TxHash: "a", Vout: 0, Amount: amount.MustParse("0.5"), ScriptType: utxo.ScriptP2WPKH},
+		{TxHash: "b", Vout: 1, Amount: amount.MustParse("0.3"), ScriptType: utxo.ScriptP2WPKH},
+		{TxHash: "c", Vout: 0, Amount: amount.MustParse("0.2"), ScriptType: utxo.ScriptP2WPKH},
+		{TxHash: "d", Vout: 2, Amount: amount.MustParse("0.00001"), ScriptType: utxo.ScriptP2WPKH},
+		{TxHash: "e", Vout: 0, Amount: amount.MustParse("2"), ScriptType: utxo.ScriptP2WPKH, Locked: true},
+	}
+}
+
+func TestSelectLargestFirst(t *testing.T) {
+	sel, err := utxo.Select(testUTXOs(), amount.MustParse("0.6"), 10, transaction.CoinSelectionLargestFirst)
+	if err != nil {
+		t.Fatalf("Select: %v", err)
+	}
+	if len(sel.Inputs) != 2 || sel.Inputs[0].TxHash != "a" || sel.Inputs[1].TxHash != "b" {
+		t.Fatalf("Expected inputs a and b, got %+v", sel.OutPoints())
+	}
+	// 11 + 2*68 + 2*31 = 209 vB at 10 sat/vB
+	if !sel.Fee.Equal(amount.MustParse("0.0000209")) || !sel.Change.Equal(amount.MustParse("0.1999791")) {
+		t.Errorf("Unexpected fee %s / change %s", sel.Fee, sel.Change)
+	}
+
+	if _, err := utxo.Select(testUTXOs(), amount.MustParse("1.1"), 10, ""); !errors.Is(err, utxo.ErrInsufficientFunds) {
+		t.Errorf("Expected locked UTXOs to be skipped and ErrInsufficientFunds, got %v", err)
+	}
+}
+
+func TestSelectBranchAndBound(t *testing.T) {
+	// 0.3 + 0.2 pays 0.5 minus the no-change fee exactly: 11 + 2*68 + 31 = 178 vB
+	target := amount.MustParse("0.5").Sub(amount.MustParse("0.0000178"))
+	sel, err := utxo.Select(testUTXOs(), target, 10, transaction.CoinSelectionBranchAndBound)
+	if err != nil {
+		t.Fatalf("Select: %v", err)
+	}
+	if len(sel.Inputs) != 2 || !sel.Change.IsZero() || !sel.Fee.Equal(amount.MustParse("0.0000178")) {
+		t.Errorf("Expected a changeless two-input match, got %+v fee %s change %s", sel.OutPoints(), sel.Fee, sel.Change)
+	}
+}
+
+func TestTransferValidatesCoinSelection(t *testing.T) {
+	client := getOfflineClient(t)
+	ctx := context.Background()
+
+	_, err := client.Transaction.Transfer(ctx, &transaction.CreateTransferRequest{
+		AccountID:     "account_id",
+		ToAddress:     "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4",
+		Amount:        amount.MustParse("0.1"),
+		Chain:         "BTC",
+		CoinSelection: transaction.CoinSelectionManual,
+	})
+	if err == nil {
+		t.Error("Expected MANUAL coin selection without inputs to be rejected")
+	}
+
+	_, err = client.Transaction.Transfer(ctx, &transaction.CreateTransferRequest{
+		AccountID:     "account_id",
+		ToAddress:     "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		Amount:        amount.MustParse("0.1"),
+		Chain:         "ETH",
+		CoinSelection: transaction.CoinSelectionLargestFirst,
+	})
+	if err == nil {
+		t.Error("Expected coin selection on an account-model chain to be rejected")
+	}
+}
+
+func TestConsolidate(t *testing.T) {
+	api := newFakeAPI(t)
+	feeRate := int64(3)
+	api.handle("/api/v1/fees", func(caller string, r *http.Request) (interface{}, string) {
+		return &utxo.FeeRates{Fastest: 40, HalfHour: 20, Hour: 10, Economy: feeRate}, ""
+	})
+	api.handle("/api/v1/accounts/account-1/utxos", func(caller string, r *http.Request) (interface{}, string) {
+		return []*utxo.UTXO{
+			{TxHash: "big", Amount: amount.MustParse("0.5")},
+			{TxHash: "dust1", Amount: amount.MustParse("0.0001")},
+			{TxHash: "dust2", Amount: amount.MustParse("0.0002")},
+			{TxHash: "uneconomic", Amount: amount.MustParse("0.000001")},
+		}, ""
+	})
+	var submitted transaction.CreateTransferRequest
+	api.handle("/api/v1/transactions", func(caller string, r *http.Request) (interface{}, string) {
+		json.NewDecoder(r.Body).Decode(&submitted)
+		return &transaction.Transaction{TxID: "tx-1"}, ""
+	})
+
+	client := api.client(t, "key")
+	req := &utxo.ConsolidateRequest{
+		AccountID:     "account-1",
+		ToAddress:     "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4",
+		Network:       "mainnet",
+		DustThreshold: amount.MustParse("0.001"),
+		MaxFeeRate:    5,
+	}
+	result, err := client.UTXO.Consolidate(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Consolidate: %v", err)
+	}
+	// 11 + 2*68 + 31 = 178 vB at 3 sat/vB = 534 sat
+	if len(submitted.Inputs) != 2 || submitted.Inputs[0].TxHash != "dust1" || submitted.CoinSelection != transaction.CoinSelectionManual {
+		t.Errorf("Expected a manual transfer of both dust outputs, got %+v", submitted)
+	}
+	if !result.Amount.Equal(amount.MustParse("0.00029466")) || submitted.FeeRate != "3" {
+		t.Errorf("Unexpected output %s at %s sat/vB", result.Amount, submitted.FeeRate)
+	}
+
+	feeRate = 12
+	if _, err := client.UTXO.Consolidate(context.Background(), req); !errors.Is(err, utxo.ErrFeeTooHigh) {
+		t.Errorf("Expected ErrFeeTooHigh, got %v", err)
+	}
+}
@@ -0,0 +1,204 @@
+package transaction
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/paratro/paratro-sdk-go/address"
+	"github.com/paratro/paratro-sdk-go/amount"
+	"github.com/paratro/paratro-sdk-go/common"
+)
+
+// Batch modes
+const (
+	BatchModeNative = "NATIVE"  // One on-chain transaction with many outputs (BTC)
+	BatchModeFanOut = "FAN_OUT" // One on-chain transaction per line
+)
+
+// BatchStatus is the state of a batch transfer
+type BatchStatus string
+
+// Batch statuses
+const (
+	BatchStatusProcessing      BatchStatus = "PROCESSING"
+	BatchStatusCompleted       BatchStatus = "COMPLETED"
+	BatchStatusPartiallyFailed BatchStatus = "PARTIALLY_FAILED"
+	BatchStatusFailed          BatchStatus = "FAILED"
+)
+
+var batchStatuses = []string{
+	string(BatchStatusProcessing), string(BatchStatusCompleted),
+	string(BatchStatusPartiallyFailed), string(BatchStatusFailed),
+}
+
+// ParseBatchStatus returns the batch status for s, ignoring case
+func ParseBatchStatus(s string) (BatchStatus, error) {
+	v, err := common.ParseEnum("batch status", s, batchStatuses...)
+	return BatchStatus(v), err
+}
+
+// IsKnown reports whether s is one of the BatchStatus constants
+func (s BatchStatus) IsKnown() bool {
+	v, err := ParseBatchStatus(string(s))
+	return err == nil && v == s
+}
+
+// UnmarshalJSON accepts any case and keeps unknown statuses as sent
+func (s *BatchStatus) UnmarshalJSON(data []byte) error {
+	v, err := common.UnmarshalEnum(data, batchStatuses...)
+	*s = BatchStatus(v)
+	return err
+}
+
+// BatchTransferLine is one recipient of a batch transfer
+type BatchTransferLine struct {
+	ToAddress      string        `json:"to_address"`
+	Amount         amount.Amount `json:"amount"`
+	Memo           string        `json:"memo,omitempty"` // Shorthand for Extras.Memo
+	Extras         *Extras       `json:"extras,omitempty"`
+	IdempotencyKey string        `json:"idempotency_key"` // Unique per line; resubmitting a key never pays twice
+}
+
+// CreateBatchTransferRequest represents a request to pay many recipients
+type CreateBatchTransferRequest struct {
+	AccountID string              `json:"account_id"`
+	AssetID   string              `json:"asset_id"`
+	Chain     common.Chain        `json:"chain,omitempty"` // Used to validate addresses and pick the mode
+	Network   common.Network      `json:"network,omitempty"`
+	Mode      string              `json:"mode,omitempty"` // NATIVE, FAN_OUT; chosen from Chain when empty
+	Lines     []BatchTransferLine `json:"lines"`
+}
+
+// BatchLine is the state of one line of a batch
+type BatchLine struct {
+	LineID         string        `json:"line_id"`
+	IdempotencyKey string        `json:"idempotency_key"`
+	ToAddress      string        `json:"to_address"`
+	Amount         amount.Amount `json:"amount"`
+	TxID           string        `json:"tx_id,omitempty"`
+	TxHash         string        `json:"tx_hash,omitempty"`
+	Status         Status        `json:"status"` // PENDING, CONFIRMING, CONFIRMED, FAILED, REJECTED
+	Error          string        `json:"error,omitempty"`
+}
+
+// Done reports whether the line has reached a final status
+func (l *BatchLine) Done() bool {
+	switch l.Status {
+	case StatusConfirmed, StatusFailed, StatusDropped, StatusRejected:
+		return true
+	}
+	return false
+}
+
+// Batch represents a batch transfer
+type Batch struct {
+	BatchID   string       `json:"batch_id"`
+	AccountID string       `json:"account_id"`
+	AssetID   string       `json:"asset_id"`
+	Mode      string       `json:"mode"`
+	Status    BatchStatus  `json:"status"`
+	Lines     []*BatchLine `json:"lines"`
+	CreatedAt common.Time  `json:"created_at"`
+	UpdatedAt common.Time  `json:"updated_at"`
+}
+
+// Done reports whether every line of the batch has reached a final status
+func (b *Batch) Done() bool {
+	if b.Status != BatchStatusProcessing && b.Status != "" {
+		return true
+	}
+	for _, line := range b.Lines {
+		if !line.Done() {
+			return false
+		}
+	}
+	return len(b.Lines) > 0
+}
+
+// CreateBatch submits a batch transfer. Lines are validated locally first:
+// every line needs a positive amount and a unique idempotency key, and
+// destinations and extras are checked when Chain is set.
+func (s *Service) CreateBatch(ctx context.Context, req *CreateBatchTransferRequest) (*Batch, error) {
+	if len(req.Lines) == 0 {
+		return nil, fmt.Errorf("batch has no lines")
+	}
+
+	body := *req
+	body.Lines = make([]BatchTransferLine, len(req.Lines))
+	keys := make(map[string]bool, len(req.Lines))
+	for i, line := range req.Lines {
+		if line.IdempotencyKey == "" {
+			return nil, fmt.Errorf("line %d: idempotency key is required", i)
+		}
+		if keys[line.IdempotencyKey] {
+			return nil, fmt.Errorf("line %d: duplicate idempotency key %q", i, line.IdempotencyKey)
+		}
+		keys[line.IdempotencyKey] = true
+
+		if line.Amount.Sign() <= 0 {
+			return nil, fmt.Errorf("line %d: amount must be positive", i)
+		}
+		if err := validateDestination(req.Chain, req.Network, line.ToAddress); err != nil {
+			return nil, fmt.Errorf("line %d: %w", i, err)
+		}
+
+		extras, err := transferExtras(line.Memo, line.Extras)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i, err)
+		}
+		if err := extras.Validate(req.Chain); err != nil {
+			return nil, fmt.Errorf("line %d: %w", i, err)
+		}
+		line.Memo, line.Extras = "", extras
+		body.Lines[i] = line
+	}
+
+	if body.Mode == "" && body.Chain != "" {
+		body.Mode = BatchModeFanOut
+		if family, err := address.ChainFamily(string(body.Chain)); err == nil && family == address.FamilyBTC {
+			body.Mode = BatchModeNative
+		}
+	}
+
+	var batch Batch
+	err := s.client.Request("POST", "/api/v1/transactions/batches", &body, &batch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create batch transfer: %w", err)
+	}
+	return &batch, nil
+}
+
+// GetBatch retrieves a batch transfer by ID
+func (s *Service) GetBatch(ctx context.Context, batchID string) (*Batch, error) {
+	var batch Batch
+	path := fmt.Sprintf("/api/v1/transactions/batches/%s", batchID)
+	err := s.client.Request("GET", path, nil, &batch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get batch transfer: %w", err)
+	}
+	return &batch, nil
+}
+
+// WaitBatch polls a batch every interval until it is done or the context is
+// cancelled, and returns the last state seen
+func (s *Service) WaitBatch(ctx context.Context, batchID string, interval time.Duration) (*Batch, error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		batch, err := s.GetBatch(ctx, batchID)
+		if err != nil {
+			return nil, err
+		}
+		if batch.Done() {
+			return batch, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return batch, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
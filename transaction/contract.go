@@ -0,0 +1,99 @@
+package transaction
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/paratro/paratro-sdk-go/abi"
+	"github.com/paratro/paratro-sdk-go/address"
+	"github.com/paratro/paratro-sdk-go/amount"
+	"github.com/paratro/paratro-sdk-go/common"
+)
+
+// ContractCallRequest represents a request to invoke a smart contract.
+// Provide either Data (ABI-encoded calldata) or Method and Args, which are
+// encoded with the abi package.
+type ContractCallRequest struct {
+	AccountID       string         `json:"account_id"`
+	Chain           common.Chain   `json:"chain,omitempty"` // Used to validate ContractAddress before submitting
+	Network         common.Network `json:"network,omitempty"`
+	ContractAddress string         `json:"contract_address"`
+	Data            string         `json:"data,omitempty"`      // 0x-prefixed calldata
+	Method          string         `json:"-"`                   // e.g. "approve(address,uint256)"
+	Args            []interface{}  `json:"-"`                   // Arguments for Method
+	Value           amount.Amount  `json:"value"`               // Native amount sent with the call, in display units
+	GasLimit        uint64         `json:"gas_limit,omitempty"` // EVM
+	FeeLimit        string         `json:"fee_limit,omitempty"` // TRX, in sun
+	Memo            string         `json:"memo,omitempty"`
+	IdempotencyKey  string         `json:"idempotency_key,omitempty"`
+}
+
+// CallContract submits a contract call and returns the created transaction
+func (s *Service) CallContract(ctx context.Context, req *ContractCallRequest) (*Transaction, error) {
+	if req.Chain != "" {
+		if err := address.Validate(string(req.Chain), string(req.Network), req.ContractAddress); err != nil {
+			return nil, fmt.Errorf("invalid contract address: %w", err)
+		}
+	}
+
+	body := *req
+	switch {
+	case body.Method != "" && body.Data != "":
+		return nil, fmt.Errorf("set either data or method, not both")
+	case body.Method != "":
+		data, err := abi.EncodeCallHex(body.Method, body.Args...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode contract call: %w", err)
+		}
+		body.Data = data
+	case body.Data != "":
+		if _, err := hex.DecodeString(strings.TrimPrefix(body.Data, "0x")); err != nil {
+			return nil, fmt.Errorf("contract call data is not hex: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("data or method is required")
+	}
+
+	var transaction Transaction
+	err := s.client.Request("POST", "/api/v1/transactions/contract-calls", &body, &transaction)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call contract: %w", err)
+	}
+	return &transaction, nil
+}
+
+// ApproveRequest represents an ERC-20 or TRC-20 approve call
+type ApproveRequest struct {
+	AccountID    string
+	Chain        common.Chain
+	Network      common.Network
+	TokenAddress string
+	Spender      string
+	Allowance    amount.Amount // In display units
+
+	// Decimals is the token's decimals, e.g. asset.Asset.Decimals. It is
+	// required so that an unset value cannot approve Allowance as base units.
+	Decimals *int
+}
+
+// Approve grants a spender an allowance of a token. The allowance is scaled
+// to the token's decimals and rejected if that would lose precision.
+func (s *Service) Approve(ctx context.Context, req *ApproveRequest) (*Transaction, error) {
+	if req.Decimals == nil || *req.Decimals < 0 {
+		return nil, fmt.Errorf("invalid allowance: token decimals are required")
+	}
+	allowance, err := req.Allowance.Rescale(*req.Decimals)
+	if err != nil {
+		return nil, fmt.Errorf("invalid allowance: %w", err)
+	}
+	return s.CallContract(ctx, &ContractCallRequest{
+		AccountID:       req.AccountID,
+		Chain:           req.Chain,
+		Network:         req.Network,
+		ContractAddress: req.TokenAddress,
+		Method:          abi.SigApprove,
+		Args:            []interface{}{req.Spender, allowance.BaseUnits()},
+	})
+}
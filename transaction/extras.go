@@ -0,0 +1,148 @@
+package transaction
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/paratro/paratro-sdk-go/address"
+	"github.com/paratro/paratro-sdk-go/common"
+)
+
+var (
+	// ErrInvalidExtras is returned when chain-specific fields do not fit the chain
+	ErrInvalidExtras = errors.New("invalid transfer extras")
+	// ErrTagRequired is returned when a destination needs a memo or tag and none is set
+	ErrTagRequired = errors.New("destination requires a memo or destination tag")
+)
+
+// Extras carries the chain-specific fields of a transfer. Only the fields
+// supported by the transfer's chain may be set.
+type Extras struct {
+	Memo           string  `json:"memo,omitempty"`            // TRX, XLM, ATOM, EOS, TON
+	DestinationTag *uint32 `json:"destination_tag,omitempty"` // XRP
+	Data           string  `json:"data,omitempty"`            // EVM: 0x-prefixed hex attached to a native transfer
+	OpReturn       string  `json:"op_return,omitempty"`       // BTC: hex payload of an OP_RETURN output
+}
+
+// HasTag reports whether a memo or destination tag is set, which exchange
+// deposit addresses use to identify the customer
+func (e *Extras) HasTag() bool {
+	return e != nil && (e.Memo != "" || e.DestinationTag != nil)
+}
+
+// extrasRules describes which extras a chain accepts
+type extrasRules struct {
+	memoBytes int // Maximum memo length; zero means memos are not supported
+	tag       bool
+	data      bool
+	opReturn  bool
+}
+
+const maxOpReturnBytes = 80 // Standard relay policy limit
+
+var chainExtrasRules = map[string]extrasRules{
+	"TRX":  {memoBytes: 256},
+	"XLM":  {memoBytes: 28},
+	"ATOM": {memoBytes: 256},
+	"EOS":  {memoBytes: 256},
+	"TON":  {memoBytes: 120},
+	"XRP":  {tag: true},
+	"BTC":  {opReturn: true},
+}
+
+func rulesForChain(chain common.Chain) (extrasRules, bool) {
+	if family, err := address.ChainFamily(string(chain)); err == nil && family == address.FamilyEVM {
+		return extrasRules{data: true}, true
+	}
+	rules, ok := chainExtrasRules[address.CanonicalChain(string(chain))]
+	return rules, ok
+}
+
+// Validate checks that the extras are supported by the chain and well
+// formed. Chains the SDK has no rules for are not checked.
+func (e *Extras) Validate(chain common.Chain) error {
+	if e == nil {
+		return nil
+	}
+	rules, ok := rulesForChain(chain)
+	if !ok {
+		return nil
+	}
+
+	if e.Memo != "" {
+		switch {
+		case rules.memoBytes == 0:
+			return fmt.Errorf("%w: %s does not support memos", ErrInvalidExtras, chain)
+		case !utf8.ValidString(e.Memo):
+			return fmt.Errorf("%w: memo is not valid UTF-8", ErrInvalidExtras)
+		case len(e.Memo) > rules.memoBytes:
+			return fmt.Errorf("%w: memo is %d bytes, %s allows %d", ErrInvalidExtras, len(e.Memo), chain, rules.memoBytes)
+		}
+	}
+	if e.DestinationTag != nil && !rules.tag {
+		return fmt.Errorf("%w: %s does not support destination tags", ErrInvalidExtras, chain)
+	}
+	if e.Data != "" {
+		if !rules.data {
+			return fmt.Errorf("%w: %s does not support data", ErrInvalidExtras, chain)
+		}
+		if !strings.HasPrefix(e.Data, "0x") {
+			return fmt.Errorf("%w: data must be 0x-prefixed hex", ErrInvalidExtras)
+		}
+		if _, err := hex.DecodeString(e.Data[2:]); err != nil {
+			return fmt.Errorf("%w: data is not hex", ErrInvalidExtras)
+		}
+	}
+	if e.OpReturn != "" {
+		if !rules.opReturn {
+			return fmt.Errorf("%w: %s does not support OP_RETURN", ErrInvalidExtras, chain)
+		}
+		payload, err := hex.DecodeString(strings.TrimPrefix(e.OpReturn, "0x"))
+		if err != nil {
+			return fmt.Errorf("%w: OP_RETURN payload is not hex", ErrInvalidExtras)
+		}
+		if len(payload) > maxOpReturnBytes {
+			return fmt.Errorf("%w: OP_RETURN payload is %d bytes, maximum is %d", ErrInvalidExtras, len(payload), maxOpReturnBytes)
+		}
+	}
+	return nil
+}
+
+// MemoExtras builds extras from a free-form memo, as stored in an address
+// book entry: XRP memos are parsed as destination tags, other chains use
+// the memo field
+func MemoExtras(chain common.Chain, memo string) (*Extras, error) {
+	if memo == "" {
+		return nil, nil
+	}
+	if rules, ok := rulesForChain(chain); ok && rules.tag {
+		tag, err := strconv.ParseUint(strings.TrimSpace(memo), 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("%w: destination tag %q is not a 32-bit number", ErrInvalidExtras, memo)
+		}
+		t := uint32(tag)
+		return &Extras{DestinationTag: &t}, nil
+	}
+	return &Extras{Memo: memo}, nil
+}
+
+// transferExtras returns the effective extras of a transfer or batch line,
+// folding the legacy Memo field into Extras
+func transferExtras(memo string, extras *Extras) (*Extras, error) {
+	if memo == "" {
+		return extras, nil
+	}
+	if extras == nil {
+		return &Extras{Memo: memo}, nil
+	}
+	if extras.Memo != "" && extras.Memo != memo {
+		return nil, fmt.Errorf("%w: memo and extras.memo differ", ErrInvalidExtras)
+	}
+	merged := *extras
+	merged.Memo = memo
+	return &merged, nil
+}
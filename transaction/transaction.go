@@ -2,9 +2,15 @@ package transaction
 
 import (
 	"context"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"strconv"
+	"strings"
+	"time"
 
+	"github.com/paratro/paratro-sdk-go/address"
+	"github.com/paratro/paratro-sdk-go/amount"
 	"github.com/paratro/paratro-sdk-go/common"
 )
 
@@ -20,26 +26,257 @@ func NewService(client *common.Client) *Service {
 
 // Transaction represents a blockchain transaction
 type Transaction struct {
-	TxID            string `json:"tx_id"`
-	WalletID        string `json:"wallet_id"`
-	AccountID       string `json:"account_id"`
-	AssetID         string `json:"asset_id,omitempty"`
-	FromAddress     string `json:"from_address"`
-	ToAddress       string `json:"to_address"`
-	Amount          string `json:"amount"`
-	Fee             string `json:"fee"`
-	Chain           string `json:"chain"`
-	Network         string `json:"network"`
-	TxHash          string `json:"tx_hash"`
-	BlockNumber     int64  `json:"block_number"`
-	Confirmations   int    `json:"confirmations"`
-	Status          string `json:"status"`  // PENDING, CONFIRMING, CONFIRMED, FAILED
-	TxType          string `json:"tx_type"` // SEND, RECEIVE
-	ContractAddress string `json:"contract_address,omitempty"`
-	Memo            string `json:"memo,omitempty"`
-	CreatedAt       string `json:"created_at"`
-	UpdatedAt       string `json:"updated_at,omitempty"`
-	ConfirmedAt     string `json:"confirmed_at,omitempty"`
+	TxID            string         `json:"tx_id"`
+	WalletID        string         `json:"wallet_id"`
+	AccountID       string         `json:"account_id"`
+	AssetID         string         `json:"asset_id,omitempty"`
+	FromAddress     string         `json:"from_address"`
+	ToAddress       string         `json:"to_address"`
+	Amount          amount.Amount  `json:"amount"`
+	Fee             amount.Amount  `json:"fee"`
+	Chain           common.Chain   `json:"chain"`
+	Network         common.Network `json:"network"`
+	TxHash          string         `json:"tx_hash"`
+	BlockNumber     int64          `json:"block_number"`
+	Confirmations   int            `json:"confirmations"`
+	Status          Status         `json:"status"`
+	TxType          TxType         `json:"tx_type"`
+	ContractAddress string         `json:"contract_address,omitempty"`
+	Memo            string         `json:"memo,omitempty"`
+	Extras          *Extras        `json:"extras,omitempty"`
+	Nonce           int64          `json:"nonce,omitempty"`             // EVM account nonce
+	ReplacesTxID    string         `json:"replaces_tx_id,omitempty"`    // Original transaction of a replacement
+	ReplacedByTxID  string         `json:"replaced_by_tx_id,omitempty"` // Replacement that superseded this transaction
+	CreatedAt       common.Time    `json:"created_at"`
+	UpdatedAt       common.Time    `json:"updated_at"`
+	ConfirmedAt     common.Time    `json:"confirmed_at"`
+}
+
+// Status is the state of a transaction
+type Status string
+
+// Transaction statuses
+const (
+	StatusAwaitingApproval Status = "AWAITING_APPROVAL" // Held until the wallet's approvers sign off
+	StatusPending          Status = "PENDING"
+	StatusConfirming       Status = "CONFIRMING"
+	StatusConfirmed        Status = "CONFIRMED"
+	StatusFailed           Status = "FAILED"
+	StatusRejected         Status = "REJECTED" // Rejected by an approver; never signed
+	StatusReplaced         Status = "REPLACED" // Superseded by a cancel or speed-up replacement
+	StatusDropped          Status = "DROPPED"  // Evicted from the mempool without confirming
+)
+
+var statuses = []string{
+	string(StatusAwaitingApproval), string(StatusPending), string(StatusConfirming), string(StatusConfirmed),
+	string(StatusFailed), string(StatusRejected), string(StatusReplaced), string(StatusDropped),
+}
+
+// ParseStatus returns the transaction status for s, ignoring case
+func ParseStatus(s string) (Status, error) {
+	v, err := common.ParseEnum("transaction status", s, statuses...)
+	return Status(v), err
+}
+
+// IsKnown reports whether s is one of the Status constants
+func (s Status) IsKnown() bool {
+	v, err := ParseStatus(string(s))
+	return err == nil && v == s
+}
+
+// UnmarshalJSON accepts any case and keeps unknown statuses as sent
+func (s *Status) UnmarshalJSON(data []byte) error {
+	v, err := common.UnmarshalEnum(data, statuses...)
+	*s = Status(v)
+	return err
+}
+
+// TxType is the direction of a transaction relative to the account
+type TxType string
+
+// Transaction types
+const (
+	TxTypeSend    TxType = "SEND"
+	TxTypeReceive TxType = "RECEIVE"
+)
+
+var txTypes = []string{string(TxTypeSend), string(TxTypeReceive)}
+
+// ParseTxType returns the transaction type for s, ignoring case
+func ParseTxType(s string) (TxType, error) {
+	v, err := common.ParseEnum("transaction type", s, txTypes...)
+	return TxType(v), err
+}
+
+// IsKnown reports whether t is one of the TxType constants
+func (t TxType) IsKnown() bool {
+	v, err := ParseTxType(string(t))
+	return err == nil && v == t
+}
+
+// UnmarshalJSON accepts any case and keeps unknown types as sent
+func (t *TxType) UnmarshalJSON(data []byte) error {
+	v, err := common.UnmarshalEnum(data, txTypes...)
+	*t = TxType(v)
+	return err
+}
+
+// IsAwaitingApproval reports whether the transaction is held for approval
+func (t *Transaction) IsAwaitingApproval() bool {
+	return t.Status == StatusAwaitingApproval
+}
+
+// IsReplaced reports whether the transaction was superseded by a replacement
+func (t *Transaction) IsReplaced() bool {
+	return t.Status == StatusReplaced || t.ReplacedByTxID != ""
+}
+
+// CreateTransferRequest represents a request to send an asset from an account
+type CreateTransferRequest struct {
+	AccountID string         `json:"account_id"`
+	AssetID   string         `json:"asset_id"`
+	ToAddress string         `json:"to_address"`
+	Amount    amount.Amount  `json:"amount"`          // In display units
+	Chain     common.Chain   `json:"chain,omitempty"` // Used to validate ToAddress and Extras before submitting
+	Network   common.Network `json:"network,omitempty"`
+	Memo      string         `json:"memo,omitempty"` // Shorthand for Extras.Memo
+	Extras    *Extras        `json:"extras,omitempty"`
+
+	// RequireTag fails the transfer locally unless a memo or destination
+	// tag is set; use it for exchange deposit addresses
+	RequireTag bool `json:"-"`
+
+	// IdempotencyKey makes retries safe: the server returns the original
+	// transaction instead of sending twice
+	IdempotencyKey string `json:"idempotency_key,omitempty"`
+
+	// RequireAllowlisted makes the server reject the transfer unless
+	// ToAddress is an active entry in the wallet's address book
+	RequireAllowlisted bool `json:"require_allowlisted,omitempty"`
+
+	// UTXO chains only
+	FeeRate       string     `json:"fee_rate,omitempty"`       // sat/vB; server estimate when empty
+	CoinSelection string     `json:"coin_selection,omitempty"` // LARGEST_FIRST, BRANCH_AND_BOUND, MANUAL
+	Inputs        []OutPoint `json:"inputs,omitempty"`         // Required for MANUAL
+}
+
+// Coin selection strategies for UTXO chains
+const (
+	CoinSelectionLargestFirst   = "LARGEST_FIRST"
+	CoinSelectionBranchAndBound = "BRANCH_AND_BOUND" // Avoids a change output when an exact match exists
+	CoinSelectionManual         = "MANUAL"           // Spend exactly the given Inputs
+)
+
+// OutPoint identifies a transaction output
+type OutPoint struct {
+	TxHash string `json:"tx_hash"`
+	Vout   uint32 `json:"vout"`
+}
+
+// Transfer submits a transfer. When Chain is set, the destination address
+// and chain-specific extras are validated locally before the request is sent.
+func (s *Service) Transfer(ctx context.Context, req *CreateTransferRequest) (*Transaction, error) {
+	if err := validateDestination(req.Chain, req.Network, req.ToAddress); err != nil {
+		return nil, err
+	}
+	if err := validateCoinSelection(req); err != nil {
+		return nil, err
+	}
+
+	extras, err := transferExtras(req.Memo, req.Extras)
+	if err != nil {
+		return nil, err
+	}
+	if err := extras.Validate(req.Chain); err != nil {
+		return nil, err
+	}
+	if req.RequireTag && !extras.HasTag() {
+		return nil, ErrTagRequired
+	}
+
+	body := *req
+	body.Memo, body.Extras = "", extras
+
+	var transaction Transaction
+	err = s.client.Request("POST", "/api/v1/transactions", &body, &transaction)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create transfer: %w", err)
+	}
+	return &transaction, nil
+}
+
+// validateDestination checks a destination address when the chain is set.
+// Chains the address package does not support are left to the server.
+func validateDestination(chain common.Chain, network common.Network, addr string) error {
+	if chain == "" {
+		return nil
+	}
+	err := address.Validate(string(chain), string(network), addr)
+	if err != nil && !errors.Is(err, address.ErrUnsupportedChain) {
+		return fmt.Errorf("invalid destination address: %w", err)
+	}
+	return nil
+}
+
+// validateCoinSelection checks the UTXO-specific fields of a transfer
+func validateCoinSelection(req *CreateTransferRequest) error {
+	if req.CoinSelection == "" && len(req.Inputs) == 0 {
+		return nil
+	}
+	if req.Chain != "" {
+		if family, err := address.ChainFamily(string(req.Chain)); err == nil && family != address.FamilyBTC {
+			return fmt.Errorf("coin selection is only supported on UTXO chains, not %s", req.Chain)
+		}
+	}
+
+	switch req.CoinSelection {
+	case CoinSelectionManual:
+		if len(req.Inputs) == 0 {
+			return fmt.Errorf("inputs are required for %s coin selection", CoinSelectionManual)
+		}
+	case "", CoinSelectionLargestFirst, CoinSelectionBranchAndBound:
+		if len(req.Inputs) > 0 {
+			return fmt.Errorf("inputs can only be set with %s coin selection", CoinSelectionManual)
+		}
+	default:
+		return fmt.Errorf("unknown coin selection strategy %q", req.CoinSelection)
+	}
+	return nil
+}
+
+// ReplaceTransactionRequest sets the fee of a replacement transaction. When
+// no fee is set, the server applies its minimum replacement increment.
+type ReplaceTransactionRequest struct {
+	GasPrice             string `json:"gas_price,omitempty"`                // EVM legacy, in wei
+	MaxFeePerGas         string `json:"max_fee_per_gas,omitempty"`          // EVM EIP-1559, in wei
+	MaxPriorityFeePerGas string `json:"max_priority_fee_per_gas,omitempty"` // EVM EIP-1559, in wei
+	FeeRate              string `json:"fee_rate,omitempty"`                 // BTC, in sat/vB
+}
+
+// SpeedUp replaces a pending transaction with an identical one paying a
+// higher fee (EVM nonce replacement, BTC replace-by-fee). The returned
+// transaction is the replacement; its ReplacesTxID links to the original.
+func (s *Service) SpeedUp(ctx context.Context, txID string, req *ReplaceTransactionRequest) (*Transaction, error) {
+	var transaction Transaction
+	path := fmt.Sprintf("/api/v1/transactions/%s/speed-up", txID)
+	err := s.client.Request("POST", path, req, &transaction)
+	if err != nil {
+		return nil, fmt.Errorf("failed to speed up transaction: %w", err)
+	}
+	return &transaction, nil
+}
+
+// Cancel replaces a pending transaction with one that pays a higher fee and
+// returns the funds to the sender, so the original can never confirm. The
+// returned transaction is the replacement.
+func (s *Service) Cancel(ctx context.Context, txID string, req *ReplaceTransactionRequest) (*Transaction, error) {
+	var transaction Transaction
+	path := fmt.Sprintf("/api/v1/transactions/%s/cancel", txID)
+	err := s.client.Request("POST", path, req, &transaction)
+	if err != nil {
+		return nil, fmt.Errorf("failed to cancel transaction: %w", err)
+	}
+	return &transaction, nil
 }
 
 // Get retrieves a transaction by ID
@@ -53,15 +290,68 @@ func (s *Service) Get(ctx context.Context, txID string) (*Transaction, error) {
 	return &transaction, nil
 }
 
+// GetByHash retrieves every transaction recorded for an on-chain hash. A
+// single hash may map to several transactions, for example a batched
+// transfer or a transaction moving multiple tokens.
+func (s *Service) GetByHash(ctx context.Context, chain common.Chain, network common.Network, txHash string) ([]*Transaction, error) {
+	params := map[string]string{
+		"chain":   string(chain),
+		"network": string(network),
+		"tx_hash": normalizeHash(txHash),
+	}
+
+	var transactions []*Transaction
+	err := s.client.RequestWithQuery("/api/v1/transactions/by-hash", params, &transactions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transactions by hash: %w", err)
+	}
+	return transactions, nil
+}
+
+// normalizeHash lowercases hex hashes, which explorers display in either case
+func normalizeHash(txHash string) string {
+	txHash = strings.TrimSpace(txHash)
+	body := strings.TrimPrefix(strings.TrimPrefix(txHash, "0x"), "0X")
+	if _, err := hex.DecodeString(body); err != nil {
+		return txHash
+	}
+	return strings.ToLower(txHash)
+}
+
 // ListTransactionsRequest represents a request to list transactions
 type ListTransactionsRequest struct {
-	WalletID  string `json:"wallet_id,omitempty"`
-	AccountID string `json:"account_id,omitempty"`
-	Status    string `json:"status,omitempty"`
+	WalletID    string         `json:"wallet_id,omitempty"`
+	AccountID   string         `json:"account_id,omitempty"`
+	AssetID     string         `json:"asset_id,omitempty"`
+	Status      Status         `json:"status,omitempty"`
+	TxType      TxType         `json:"tx_type,omitempty"`
+	Chain       common.Chain   `json:"chain,omitempty"`
+	Network     common.Network `json:"network,omitempty"`
+	TxHash      string         `json:"tx_hash,omitempty"`
+	FromAddress string         `json:"from_address,omitempty"`
+	ToAddress   string         `json:"to_address,omitempty"`
+
+	// Amount range, inclusive; nil leaves the bound open
+	MinAmount *amount.Amount `json:"min_amount,omitempty"`
+	MaxAmount *amount.Amount `json:"max_amount,omitempty"`
+
+	// Time windows, inclusive; zero values leave the bound open
+	CreatedFrom   time.Time `json:"-"`
+	CreatedTo     time.Time `json:"-"`
+	ConfirmedFrom time.Time `json:"-"`
+	ConfirmedTo   time.Time `json:"-"`
+
+	SortOrder string `json:"sort_order,omitempty"` // asc, desc (by creation time)
 	Page      int    `json:"page,omitempty"`
 	PageSize  int    `json:"page_size,omitempty"`
 }
 
+// Sort orders for ListTransactionsRequest
+const (
+	SortAsc  = "asc"
+	SortDesc = "desc"
+)
+
 // ListTransactionsResponse represents a paginated list of transactions
 type ListTransactionsResponse struct {
 	Items      []*Transaction `json:"items"`
@@ -82,8 +372,54 @@ func (s *Service) List(ctx context.Context, req *ListTransactionsRequest) (*List
 		if req.AccountID != "" {
 			params["account_id"] = req.AccountID
 		}
+		if req.AssetID != "" {
+			params["asset_id"] = req.AssetID
+		}
 		if req.Status != "" {
-			params["status"] = req.Status
+			params["status"] = string(req.Status)
+		}
+		if req.TxType != "" {
+			params["tx_type"] = string(req.TxType)
+		}
+		if req.Chain != "" {
+			params["chain"] = string(req.Chain)
+		}
+		if req.Network != "" {
+			params["network"] = string(req.Network)
+		}
+		if req.TxHash != "" {
+			params["tx_hash"] = req.TxHash
+		}
+		if req.FromAddress != "" {
+			params["from_address"] = req.FromAddress
+		}
+		if req.ToAddress != "" {
+			params["to_address"] = req.ToAddress
+		}
+		if req.MinAmount != nil {
+			params["min_amount"] = req.MinAmount.String()
+		}
+		if req.MaxAmount != nil {
+			params["max_amount"] = req.MaxAmount.String()
+		}
+		if !req.CreatedFrom.IsZero() {
+			params["created_from"] = req.CreatedFrom.UTC().Format(time.RFC3339Nano)
+		}
+		if !req.CreatedTo.IsZero() {
+			params["created_to"] = req.CreatedTo.UTC().Format(time.RFC3339Nano)
+		}
+		if !req.ConfirmedFrom.IsZero() {
+			params["confirmed_from"] = req.ConfirmedFrom.UTC().Format(time.RFC3339Nano)
+		}
+		if !req.ConfirmedTo.IsZero() {
+			params["confirmed_to"] = req.ConfirmedTo.UTC().Format(time.RFC3339Nano)
+		}
+		if req.SortOrder != "" {
+			order, err := common.ParseEnum("sort order", req.SortOrder, SortAsc, SortDesc)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list transactions: %w", err)
+			}
+			params["sort_order"] = order
 		}
 		if req.Page > 0 {
 			params["page"] = strconv.Itoa(req.Page)
@@ -96,9 +432,26 @@ func (s *Service) List(ctx context.Context, req *ListTransactionsRequest) (*List
 	var transactions []*Transaction
 	err := s.client.RequestWithQuery("/api/v1/transactions", params, &transactions)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list transactions: %v", err)
+		return nil, fmt.Errorf("failed to list transactions: %w", err)
 	}
 	return &ListTransactionsResponse{
 		Items: transactions,
 	}, nil
 }
+
+// ListAll retrieves every page of transactions matching req, ignoring its Page
+// and PageSize
+func (s *Service) ListAll(ctx context.Context, req *ListTransactionsRequest) ([]*Transaction, error) {
+	filter := ListTransactionsRequest{}
+	if req != nil {
+		filter = *req
+	}
+	return common.ListAll(common.DefaultPageSize, func(page, pageSize int) ([]*Transaction, error) {
+		filter.Page, filter.PageSize = page, pageSize
+		resp, err := s.List(ctx, &filter)
+		if err != nil {
+			return nil, err
+		}
+		return resp.Items, nil
+	})
+}
@@ -0,0 +1,148 @@
+package utxo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/paratro/paratro-sdk-go/amount"
+	"github.com/paratro/paratro-sdk-go/common"
+	"github.com/paratro/paratro-sdk-go/transaction"
+)
+
+var (
+	// ErrFeeTooHigh is returned when the current fee rate is above the
+	// consolidation's MaxFeeRate
+	ErrFeeTooHigh = errors.New("fee rate is above the consolidation limit")
+	// ErrNothingToConsolidate is returned when too few UTXOs are worth sweeping
+	ErrNothingToConsolidate = errors.New("nothing to consolidate")
+)
+
+// ConsolidateRequest represents a request to sweep small UTXOs of an
+// account into a single output
+type ConsolidateRequest struct {
+	AccountID string
+	AssetID   string
+	ToAddress string // Usually one of the account's own addresses
+	Network   common.Network
+
+	// DustThreshold selects UTXOs below this amount; zero selects all
+	DustThreshold amount.Amount
+	// MaxFeeRate in sat/vB; consolidation is skipped with ErrFeeTooHigh
+	// while the economy estimate is higher
+	MaxFeeRate int64
+	// MinInputs is the fewest UTXOs worth consolidating (default 2)
+	MinInputs int
+	// MaxInputs bounds the transaction size (default 200)
+	MaxInputs int
+
+	DryRun         bool // Plan without submitting
+	IdempotencyKey string
+}
+
+// Consolidation describes a planned or submitted consolidation
+type Consolidation struct {
+	Inputs      []*UTXO
+	FeeRate     int64
+	Fee         amount.Amount
+	Amount      amount.Amount            // Value of the single output
+	Transaction *transaction.Transaction // Nil for dry runs
+}
+
+// Consolidate sweeps small UTXOs into one output when fees are low. UTXOs
+// that would cost more in fees than they are worth are left alone.
+func (s *Service) Consolidate(ctx context.Context, req *ConsolidateRequest) (*Consolidation, error) {
+	minInputs := req.MinInputs
+	if minInputs < 2 {
+		minInputs = 2
+	}
+	maxInputs := req.MaxInputs
+	if maxInputs <= 0 {
+		maxInputs = 200
+	}
+	threshold, err := sats(req.DustThreshold)
+	if err != nil {
+		return nil, err
+	}
+
+	rates, err := s.FeeRates(ctx, req.Network)
+	if err != nil {
+		return nil, err
+	}
+	feeRate := rates.Economy
+	if req.MaxFeeRate > 0 && feeRate > req.MaxFeeRate {
+		return nil, fmt.Errorf("%w: %d sat/vB > %d sat/vB", ErrFeeTooHigh, feeRate, req.MaxFeeRate)
+	}
+
+	utxos, err := s.List(ctx, req.AccountID, &ListUTXOsRequest{MinConfirmations: 1})
+	if err != nil {
+		return nil, err
+	}
+
+	var picked []candidate
+	for _, u := range utxos {
+		value, err := sats(u.Amount)
+		if err != nil {
+			return nil, err
+		}
+		c := candidate{utxo: u, value: value, vsize: InputVsize(u.ScriptType)}
+		if u.Locked || (threshold > 0 && value >= threshold) || c.effectiveValue(feeRate) <= 0 {
+			continue
+		}
+		picked = append(picked, c)
+	}
+	// Smallest first, so the dust goes before larger outputs
+	sort.SliceStable(picked, func(i, j int) bool {
+		return picked[i].value < picked[j].value
+	})
+	if len(picked) > maxInputs {
+		picked = picked[:maxInputs]
+	}
+	if len(picked) < minInputs {
+		return nil, fmt.Errorf("%w: %d eligible utxos, need %d", ErrNothingToConsolidate, len(picked), minInputs)
+	}
+
+	var total, inputVsize int64
+	for _, c := range picked {
+		total += c.value
+		inputVsize += c.vsize
+	}
+	fee := (txOverheadVsize + inputVsize + outputVsize) * feeRate
+	if total-fee < DustLimit {
+		return nil, fmt.Errorf("%w: output would be dust", ErrNothingToConsolidate)
+	}
+
+	result := &Consolidation{
+		FeeRate: feeRate,
+		Fee:     amount.FromInt64(fee, Decimals),
+		Amount:  amount.FromInt64(total-fee, Decimals),
+	}
+	inputs := make([]transaction.OutPoint, len(picked))
+	for i, c := range picked {
+		result.Inputs = append(result.Inputs, c.utxo)
+		inputs[i] = c.utxo.OutPoint()
+	}
+	if req.DryRun {
+		return result, nil
+	}
+
+	tx, err := s.transactions.Transfer(ctx, &transaction.CreateTransferRequest{
+		AccountID:      req.AccountID,
+		AssetID:        req.AssetID,
+		ToAddress:      req.ToAddress,
+		Amount:         result.Amount,
+		Chain:          common.ChainBitcoin,
+		Network:        req.Network,
+		FeeRate:        strconv.FormatInt(feeRate, 10),
+		CoinSelection:  transaction.CoinSelectionManual,
+		Inputs:         inputs,
+		IdempotencyKey: req.IdempotencyKey,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to consolidate utxos: %w", err)
+	}
+	result.Transaction = tx
+	return result, nil
+}
@@ -0,0 +1,206 @@
+package utxo
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/paratro/paratro-sdk-go/amount"
+	"github.com/paratro/paratro-sdk-go/transaction"
+)
+
+// ErrInsufficientFunds is returned when the spendable UTXOs cannot cover a
+// target amount plus fees
+var ErrInsufficientFunds = errors.New("insufficient funds")
+
+// DustLimit is the smallest output, in satoshis, that standard relay policy
+// accepts; smaller change is added to the fee instead
+const DustLimit = 546
+
+// Virtual sizes in vbytes used for fee estimates
+const (
+	txOverheadVsize = 11
+	outputVsize     = 31 // P2WPKH
+	bnbMaxTries     = 100000
+)
+
+// InputVsize returns the estimated virtual size of spending an output of
+// the given script type; unknown types are estimated as P2WPKH
+func InputVsize(scriptType string) int64 {
+	switch scriptType {
+	case ScriptP2PKH:
+		return 148
+	case ScriptP2SHP2WPKH:
+		return 91
+	case ScriptP2TR:
+		return 58
+	}
+	return 68
+}
+
+// Selection is the result of a local coin selection
+type Selection struct {
+	Inputs     []*UTXO
+	InputTotal amount.Amount
+	Fee        amount.Amount
+	Change     amount.Amount // Zero when the remainder was too small for a change output
+}
+
+// OutPoints returns the selected inputs for a MANUAL transfer
+func (s *Selection) OutPoints() []transaction.OutPoint {
+	points := make([]transaction.OutPoint, len(s.Inputs))
+	for i, u := range s.Inputs {
+		points[i] = u.OutPoint()
+	}
+	return points
+}
+
+// Select previews which unlocked UTXOs a strategy would spend to pay target
+// to one recipient at feeRate sat/vB. BRANCH_AND_BOUND looks for an input
+// set that needs no change output and falls back to LARGEST_FIRST.
+func Select(utxos []*UTXO, target amount.Amount, feeRate int64, strategy string) (*Selection, error) {
+	targetSats, err := sats(target)
+	if err != nil {
+		return nil, err
+	}
+	if targetSats <= 0 {
+		return nil, fmt.Errorf("target must be positive")
+	}
+	if feeRate <= 0 {
+		return nil, fmt.Errorf("fee rate must be positive")
+	}
+
+	var spendable []candidate
+	for _, u := range utxos {
+		if u.Locked {
+			continue
+		}
+		value, err := sats(u.Amount)
+		if err != nil {
+			return nil, err
+		}
+		spendable = append(spendable, candidate{utxo: u, value: value, vsize: InputVsize(u.ScriptType)})
+	}
+	sort.SliceStable(spendable, func(i, j int) bool {
+		return spendable[i].value > spendable[j].value
+	})
+
+	switch strategy {
+	case transaction.CoinSelectionBranchAndBound:
+		if sel := branchAndBound(spendable, targetSats, feeRate); sel != nil {
+			return sel, nil
+		}
+		return largestFirst(spendable, targetSats, feeRate)
+	case transaction.CoinSelectionLargestFirst, "":
+		return largestFirst(spendable, targetSats, feeRate)
+	}
+	return nil, fmt.Errorf("cannot preview %q coin selection", strategy)
+}
+
+type candidate struct {
+	utxo  *UTXO
+	value int64
+	vsize int64
+}
+
+func (c candidate) effectiveValue(feeRate int64) int64 {
+	return c.value - c.vsize*feeRate
+}
+
+func largestFirst(spendable []candidate, target, feeRate int64) (*Selection, error) {
+	var total, inputVsize int64
+	for i, c := range spendable {
+		total += c.value
+		inputVsize += c.vsize
+
+		withChange := (txOverheadVsize + inputVsize + 2*outputVsize) * feeRate
+		if change := total - target - withChange; change >= DustLimit {
+			return newSelection(spendable[:i+1], total, withChange, change), nil
+		}
+		noChange := (txOverheadVsize + inputVsize + outputVsize) * feeRate
+		if total >= target+noChange {
+			return newSelection(spendable[:i+1], total, total-target, 0), nil
+		}
+	}
+	return nil, fmt.Errorf("%w: have %s, need more than %s", ErrInsufficientFunds,
+		amount.FromInt64(total, Decimals), amount.FromInt64(target, Decimals))
+}
+
+// branchAndBound searches for the input set whose effective value lands
+// closest above the target without paying for a change output
+func branchAndBound(spendable []candidate, target, feeRate int64) *Selection {
+	var cands []candidate
+	var remaining int64
+	for _, c := range spendable {
+		if ev := c.effectiveValue(feeRate); ev > 0 {
+			cands = append(cands, c)
+			remaining += ev
+		}
+	}
+
+	low := target + (txOverheadVsize+outputVsize)*feeRate
+	high := low + (outputVsize+InputVsize(ScriptP2WPKH))*feeRate // Cost of creating and later spending change
+
+	var best, current []int
+	bestWaste := int64(-1)
+	tries := 0
+
+	var search func(i int, sum, remaining int64)
+	search = func(i int, sum, remaining int64) {
+		tries++
+		if tries > bnbMaxTries || bestWaste == 0 || sum > high {
+			return
+		}
+		if sum >= low {
+			if waste := sum - low; bestWaste < 0 || waste < bestWaste {
+				best = append(best[:0], current...)
+				bestWaste = waste
+			}
+			return
+		}
+		if i == len(cands) || sum+remaining < low {
+			return
+		}
+		ev := cands[i].effectiveValue(feeRate)
+		current = append(current, i)
+		search(i+1, sum+ev, remaining-ev)
+		current = current[:len(current)-1]
+		search(i+1, sum, remaining-ev)
+	}
+	search(0, 0, remaining)
+
+	if bestWaste < 0 {
+		return nil
+	}
+	selected := make([]candidate, len(best))
+	var total int64
+	for i, idx := range best {
+		selected[i] = cands[idx]
+		total += cands[idx].value
+	}
+	return newSelection(selected, total, total-target, 0)
+}
+
+func newSelection(selected []candidate, total, fee, change int64) *Selection {
+	sel := &Selection{
+		InputTotal: amount.FromInt64(total, Decimals),
+		Fee:        amount.FromInt64(fee, Decimals),
+		Change:     amount.FromInt64(change, Decimals),
+	}
+	for _, c := range selected {
+		sel.Inputs = append(sel.Inputs, c.utxo)
+	}
+	return sel
+}
+
+// sats converts a BTC amount to satoshis
+func sats(a amount.Amount) (int64, error) {
+	v, err := a.Rescale(Decimals)
+	if err != nil {
+		return 0, err
+	}
+	if !v.BaseUnits().IsInt64() {
+		return 0, fmt.Errorf("%w: %s BTC is out of range", amount.ErrInvalidAmount, a)
+	}
+	return v.BaseUnits().Int64(), nil
+}
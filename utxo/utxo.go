@@ -0,0 +1,103 @@
+// Package utxo exposes the unspent outputs of Bitcoin accounts, previews
+// coin selection locally and consolidates dust into a single output.
+package utxo
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/paratro/paratro-sdk-go/amount"
+	"github.com/paratro/paratro-sdk-go/common"
+	"github.com/paratro/paratro-sdk-go/transaction"
+)
+
+// Decimals is the number of decimals of BTC amounts (satoshis)
+const Decimals = 8
+
+// Script types
+const (
+	ScriptP2PKH      = "P2PKH"
+	ScriptP2SHP2WPKH = "P2SH-P2WPKH"
+	ScriptP2WPKH     = "P2WPKH"
+	ScriptP2TR       = "P2TR"
+)
+
+// Service handles UTXO-related API operations
+type Service struct {
+	client       *common.Client
+	transactions *transaction.Service
+}
+
+// NewService creates a new UTXO service that submits consolidations through
+// transactions
+func NewService(client *common.Client, transactions *transaction.Service) *Service {
+	return &Service{client: client, transactions: transactions}
+}
+
+// UTXO is an unspent transaction output owned by an account
+type UTXO struct {
+	TxHash        string        `json:"tx_hash"`
+	Vout          uint32        `json:"vout"`
+	Address       string        `json:"address"`
+	Amount        amount.Amount `json:"amount"` // In BTC
+	ScriptType    string        `json:"script_type"`
+	Confirmations int           `json:"confirmations"`
+	Locked        bool          `json:"locked"` // Reserved by a pending transaction
+}
+
+// OutPoint returns the output's identifier for manual coin selection
+func (u *UTXO) OutPoint() transaction.OutPoint {
+	return transaction.OutPoint{TxHash: u.TxHash, Vout: u.Vout}
+}
+
+// ListUTXOsRequest represents a request to list an account's UTXOs
+type ListUTXOsRequest struct {
+	MinConfirmations int  `json:"min_confirmations,omitempty"`
+	IncludeLocked    bool `json:"include_locked,omitempty"`
+}
+
+// List retrieves the unspent outputs of a BTC account
+func (s *Service) List(ctx context.Context, accountID string, req *ListUTXOsRequest) ([]*UTXO, error) {
+	params := make(map[string]string)
+
+	if req != nil {
+		if req.MinConfirmations > 0 {
+			params["min_confirmations"] = strconv.Itoa(req.MinConfirmations)
+		}
+		if req.IncludeLocked {
+			params["include_locked"] = "true"
+		}
+	}
+
+	var utxos []*UTXO
+	path := fmt.Sprintf("/api/v1/accounts/%s/utxos", accountID)
+	err := s.client.RequestWithQuery(path, params, &utxos)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list utxos: %w", err)
+	}
+	return utxos, nil
+}
+
+// FeeRates are current fee rate estimates in sat/vB
+type FeeRates struct {
+	Fastest  int64 `json:"fastest"`   // Next block
+	HalfHour int64 `json:"half_hour"` // ~3 blocks
+	Hour     int64 `json:"hour"`      // ~6 blocks
+	Economy  int64 `json:"economy"`   // No time target
+}
+
+// FeeRates retrieves current BTC fee rate estimates for a network
+func (s *Service) FeeRates(ctx context.Context, network common.Network) (*FeeRates, error) {
+	params := map[string]string{
+		"chain":   string(common.ChainBitcoin),
+		"network": string(network),
+	}
+
+	var rates FeeRates
+	err := s.client.RequestWithQuery("/api/v1/fees", params, &rates)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get fee rates: %w", err)
+	}
+	return &rates, nil
+}
@@ -0,0 +1,243 @@
+package wallet
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/paratro/paratro-sdk-go/address"
+	"github.com/paratro/paratro-sdk-go/amount"
+	"github.com/paratro/paratro-sdk-go/common"
+	"github.com/paratro/paratro-sdk-go/transaction"
+)
+
+// ProspectiveTransfer is a transfer to check against wallet policies
+type ProspectiveTransfer struct {
+	AssetID   string
+	ToAddress string
+	Chain     common.Chain // Used to compare addresses in canonical form
+	Amount    amount.Amount
+	Time      time.Time // Defaults to now
+}
+
+// Violation describes a rule that would block a transfer
+type Violation struct {
+	PolicyID string
+	RuleID   string
+	Type     string
+	Reason   string
+}
+
+// Evaluation is the result of a policy dry run
+type Evaluation struct {
+	Violations []Violation
+}
+
+// Allowed reports whether no rule would block the transfer
+func (e *Evaluation) Allowed() bool {
+	return len(e.Violations) == 0
+}
+
+var weekdays = map[string]time.Weekday{
+	"SUN": time.Sunday,
+	"MON": time.Monday,
+	"TUE": time.Tuesday,
+	"WED": time.Wednesday,
+	"THU": time.Thursday,
+	"FRI": time.Friday,
+	"SAT": time.Saturday,
+}
+
+// Lookback returns how far back recent transactions must reach for
+// Evaluate to see everything the policies count. Use it as CreatedFrom when
+// listing the wallet's SEND transactions.
+func Lookback(policies []*Policy, now time.Time) time.Time {
+	from := now.Add(-24 * time.Hour)
+	for _, p := range policies {
+		for _, r := range p.Rules {
+			if r.Type == RuleVelocity {
+				if t := now.Add(-time.Duration(r.WindowSeconds) * time.Second); t.Before(from) {
+					from = t
+				}
+			}
+		}
+	}
+	return from
+}
+
+// Evaluate dry-runs a prospective transfer against wallet policies, using
+// the wallet's recent transactions (from transaction.Service.List) for
+// daily limits and velocity rules. Disabled policies are skipped.
+func Evaluate(policies []*Policy, transfer *ProspectiveTransfer, recent []*transaction.Transaction) (*Evaluation, error) {
+	now := transfer.Time
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	sends, err := countedSends(recent)
+	if err != nil {
+		return nil, err
+	}
+
+	eval := &Evaluation{}
+	for _, p := range policies {
+		if !p.Enabled {
+			continue
+		}
+		for _, r := range p.Rules {
+			reason, err := r.check(transfer, now, sends)
+			if err != nil {
+				return nil, fmt.Errorf("policy %s: %w", p.PolicyID, err)
+			}
+			if reason != "" {
+				eval.Violations = append(eval.Violations, Violation{
+					PolicyID: p.PolicyID,
+					RuleID:   r.RuleID,
+					Type:     r.Type,
+					Reason:   reason,
+				})
+			}
+		}
+	}
+	return eval, nil
+}
+
+type send struct {
+	tx        *transaction.Transaction
+	createdAt time.Time
+}
+
+// countedSends keeps outgoing transactions that spend or may still spend
+// funds: failed, rejected, dropped and replaced ones are excluded
+func countedSends(recent []*transaction.Transaction) ([]send, error) {
+	var sends []send
+	for _, tx := range recent {
+		if tx.TxType != transaction.TxTypeSend {
+			continue
+		}
+		switch tx.Status {
+		case transaction.StatusFailed, transaction.StatusRejected, transaction.StatusDropped, transaction.StatusReplaced:
+			continue
+		}
+		if tx.CreatedAt.IsZero() {
+			return nil, fmt.Errorf("transaction %s has no created_at", tx.TxID)
+		}
+		sends = append(sends, send{tx: tx, createdAt: tx.CreatedAt.Time})
+	}
+	return sends, nil
+}
+
+// check returns why the rule blocks the transfer, or "" when it passes
+func (r *PolicyRule) check(transfer *ProspectiveTransfer, now time.Time, sends []send) (string, error) {
+	loc, err := r.location()
+	if err != nil {
+		return "", err
+	}
+
+	switch r.Type {
+	case RuleDailyLimit:
+		if r.AssetID != transfer.AssetID || r.Limit == nil {
+			return "", nil
+		}
+		y, m, d := now.In(loc).Date()
+		dayStart := time.Date(y, m, d, 0, 0, 0, 0, loc)
+
+		total := transfer.Amount
+		for _, s := range sends {
+			if s.tx.AssetID == r.AssetID && !s.createdAt.Before(dayStart) && !s.createdAt.After(now) {
+				total = total.Add(s.tx.Amount)
+			}
+		}
+		if total.Cmp(*r.Limit) > 0 {
+			return fmt.Sprintf("daily total %s would exceed limit %s", total, r.Limit), nil
+		}
+
+	case RuleAllowlist:
+		to := comparableAddress(transfer.Chain, transfer.ToAddress)
+		for _, a := range r.Addresses {
+			if comparableAddress(transfer.Chain, a) == to {
+				return "", nil
+			}
+		}
+		return fmt.Sprintf("destination %s is not on the allowlist", transfer.ToAddress), nil
+
+	case RuleVelocity:
+		from := now.Add(-time.Duration(r.WindowSeconds) * time.Second)
+		count := 1
+		for _, s := range sends {
+			if (r.AssetID == "" || s.tx.AssetID == r.AssetID) && s.createdAt.After(from) && !s.createdAt.After(now) {
+				count++
+			}
+		}
+		if count > r.MaxCount {
+			return fmt.Sprintf("%d transfers in %s would exceed %d", count, time.Duration(r.WindowSeconds)*time.Second, r.MaxCount), nil
+		}
+
+	case RuleTimeWindow:
+		start, err := parseClock(r.StartTime)
+		if err != nil {
+			return "", err
+		}
+		end, err := parseClock(r.EndTime)
+		if err != nil {
+			return "", err
+		}
+
+		local := now.In(loc)
+		minute := local.Hour()*60 + local.Minute()
+		day := local.Weekday()
+		var inside bool
+		if start < end {
+			inside = minute >= start && minute < end
+		} else {
+			// Overnight window; after midnight it belongs to the previous day
+			inside = minute >= start || minute < end
+			if minute < end {
+				day = (day + 6) % 7
+			}
+		}
+		if !inside {
+			return fmt.Sprintf("sends are only allowed between %s and %s %s", r.StartTime, r.EndTime, loc), nil
+		}
+
+		if len(r.Days) > 0 {
+			allowed := false
+			for _, d := range r.Days {
+				if weekdays[d] == day {
+					allowed = true
+				}
+			}
+			if !allowed {
+				return fmt.Sprintf("sends are not allowed on %s", day), nil
+			}
+		}
+	}
+	return "", nil
+}
+
+func (r *PolicyRule) location() (*time.Location, error) {
+	if r.TimeZone == "" {
+		return time.UTC, nil
+	}
+	return time.LoadLocation(r.TimeZone)
+}
+
+// parseClock parses HH:MM into minutes after midnight
+func parseClock(s string) (int, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, err
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// comparableAddress normalizes an address when the chain is known and
+// falls back to a case-insensitive comparison otherwise
+func comparableAddress(chain common.Chain, addr string) string {
+	if chain != "" {
+		if normalized, err := address.Normalize(string(chain), addr); err == nil {
+			return normalized
+		}
+	}
+	return strings.ToLower(strings.TrimSpace(addr))
+}
@@ -0,0 +1,179 @@
+package wallet
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/paratro/paratro-sdk-go/amount"
+	"github.com/paratro/paratro-sdk-go/common"
+)
+
+// Policy rule types
+const (
+	RuleDailyLimit = "DAILY_LIMIT" // Total sent per asset per calendar day
+	RuleAllowlist  = "ALLOWLIST"   // Destinations must be on a list
+	RuleVelocity   = "VELOCITY"    // Number of transfers per rolling window
+	RuleTimeWindow = "TIME_WINDOW" // Sends only on given days and hours
+)
+
+// PolicyRule is one spend rule of a wallet policy. Which fields apply
+// depends on Type.
+type PolicyRule struct {
+	RuleID string `json:"rule_id,omitempty"`
+	Type   string `json:"type"` // DAILY_LIMIT, ALLOWLIST, VELOCITY, TIME_WINDOW
+
+	// AssetID scopes DAILY_LIMIT (required) and VELOCITY (optional) rules
+	AssetID string `json:"asset_id,omitempty"`
+
+	// DAILY_LIMIT: maximum total sent per calendar day in TimeZone
+	Limit *amount.Amount `json:"limit,omitempty"`
+
+	// ALLOWLIST: permitted destination addresses
+	Addresses []string `json:"addresses,omitempty"`
+
+	// VELOCITY: at most MaxCount transfers in any WindowSeconds period
+	MaxCount      int   `json:"max_count,omitempty"`
+	WindowSeconds int64 `json:"window_seconds,omitempty"`
+
+	// TIME_WINDOW: sends allowed on Days between StartTime and EndTime. A
+	// StartTime after EndTime spans midnight, and Days then names the day
+	// the window opens.
+	Days      []string `json:"days,omitempty"`       // MON, TUE, ...; empty means every day
+	StartTime string   `json:"start_time,omitempty"` // HH:MM, inclusive
+	EndTime   string   `json:"end_time,omitempty"`   // HH:MM, exclusive
+
+	// TimeZone is the IANA zone for DAILY_LIMIT and TIME_WINDOW; UTC when empty
+	TimeZone string `json:"time_zone,omitempty"`
+}
+
+// Policy is a set of spend rules enforced on a wallet's transfers
+type Policy struct {
+	PolicyID  string       `json:"policy_id"`
+	WalletID  string       `json:"wallet_id"`
+	Name      string       `json:"name"`
+	Enabled   bool         `json:"enabled"`
+	Rules     []PolicyRule `json:"rules"`
+	CreatedAt common.Time  `json:"created_at"`
+	UpdatedAt common.Time  `json:"updated_at"`
+}
+
+// CreatePolicyRequest represents a request to create a wallet policy
+type CreatePolicyRequest struct {
+	Name    string       `json:"name"`
+	Enabled bool         `json:"enabled"`
+	Rules   []PolicyRule `json:"rules"`
+}
+
+// UpdatePolicyRequest represents a partial update of a wallet policy; nil
+// fields are left unchanged
+type UpdatePolicyRequest struct {
+	Name    *string      `json:"name,omitempty"`
+	Enabled *bool        `json:"enabled,omitempty"`
+	Rules   []PolicyRule `json:"rules,omitempty"` // Replaces all rules when set
+}
+
+// CreatePolicy creates a policy on a wallet
+func (s *Service) CreatePolicy(ctx context.Context, walletID string, req *CreatePolicyRequest) (*Policy, error) {
+	if err := validateRules(req.Rules); err != nil {
+		return nil, err
+	}
+
+	var policy Policy
+	path := fmt.Sprintf("/api/v1/wallets/%s/policies", walletID)
+	err := s.client.Request("POST", path, req, &policy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create policy: %w", err)
+	}
+	return &policy, nil
+}
+
+// GetPolicy retrieves a wallet policy by ID
+func (s *Service) GetPolicy(ctx context.Context, walletID, policyID string) (*Policy, error) {
+	var policy Policy
+	path := fmt.Sprintf("/api/v1/wallets/%s/policies/%s", walletID, policyID)
+	err := s.client.Request("GET", path, nil, &policy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get policy: %w", err)
+	}
+	return &policy, nil
+}
+
+// ListPolicies retrieves every policy of a wallet
+func (s *Service) ListPolicies(ctx context.Context, walletID string) ([]*Policy, error) {
+	var policies []*Policy
+	path := fmt.Sprintf("/api/v1/wallets/%s/policies", walletID)
+	err := s.client.Request("GET", path, nil, &policies)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list policies: %w", err)
+	}
+	return policies, nil
+}
+
+// UpdatePolicy updates a wallet policy
+func (s *Service) UpdatePolicy(ctx context.Context, walletID, policyID string, req *UpdatePolicyRequest) (*Policy, error) {
+	if err := validateRules(req.Rules); err != nil {
+		return nil, err
+	}
+
+	var policy Policy
+	path := fmt.Sprintf("/api/v1/wallets/%s/policies/%s", walletID, policyID)
+	err := s.client.Request("PATCH", path, req, &policy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update policy: %w", err)
+	}
+	return &policy, nil
+}
+
+// DeletePolicy removes a wallet policy
+func (s *Service) DeletePolicy(ctx context.Context, walletID, policyID string) error {
+	path := fmt.Sprintf("/api/v1/wallets/%s/policies/%s", walletID, policyID)
+	err := s.client.Request("DELETE", path, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete policy: %w", err)
+	}
+	return nil
+}
+
+// validateRules checks that each rule carries the fields its type needs
+func validateRules(rules []PolicyRule) error {
+	for i, r := range rules {
+		if _, err := r.location(); err != nil {
+			return fmt.Errorf("rule %d: invalid time zone %q: %w", i, r.TimeZone, err)
+		}
+
+		switch r.Type {
+		case RuleDailyLimit:
+			if r.AssetID == "" || r.Limit == nil {
+				return fmt.Errorf("rule %d: %s requires asset_id and limit", i, r.Type)
+			}
+		case RuleAllowlist:
+			if len(r.Addresses) == 0 {
+				return fmt.Errorf("rule %d: %s requires addresses", i, r.Type)
+			}
+		case RuleVelocity:
+			if r.MaxCount <= 0 || r.WindowSeconds <= 0 {
+				return fmt.Errorf("rule %d: %s requires max_count and window_seconds", i, r.Type)
+			}
+		case RuleTimeWindow:
+			start, err := parseClock(r.StartTime)
+			if err != nil {
+				return fmt.Errorf("rule %d: invalid start_time: %w", i, err)
+			}
+			end, err := parseClock(r.EndTime)
+			if err != nil {
+				return fmt.Errorf("rule %d: invalid end_time: %w", i, err)
+			}
+			if start == end {
+				return fmt.Errorf("rule %d: start_time and end_time must differ", i)
+			}
+			for _, d := range r.Days {
+				if _, ok := weekdays[d]; !ok {
+					return fmt.Errorf("rule %d: invalid day %q", i, d)
+				}
+			}
+		default:
+			return fmt.Errorf("rule %d: unknown rule type %q", i, r.Type)
+		}
+	}
+	return nil
+}
@@ -20,23 +20,55 @@ func NewService(client *common.Client) *Service {
 
 // CreateWalletRequest represents a request to create a new MPC wallet
 type CreateWalletRequest struct {
-	WalletName  string `json:"wallet_name"`
-	Description string `json:"description,omitempty"`
-	Chain       string `json:"chain"`   // ETH, TRX, BTC, etc.
-	Network     string `json:"network"` // mainnet, testnet
+	WalletName  string         `json:"wallet_name"`
+	Description string         `json:"description,omitempty"`
+	Chain       common.Chain   `json:"chain"`
+	Network     common.Network `json:"network"`
+}
+
+// Status is the lifecycle state of a wallet
+type Status string
+
+// Wallet statuses
+const (
+	StatusPending Status = "PENDING"
+	StatusActive  Status = "ACTIVE"
+	StatusFrozen  Status = "FROZEN"
+	StatusDeleted Status = "DELETED"
+)
+
+var statuses = []string{string(StatusPending), string(StatusActive), string(StatusFrozen), string(StatusDeleted)}
+
+// ParseStatus returns the wallet status for s, ignoring case
+func ParseStatus(s string) (Status, error) {
+	v, err := common.ParseEnum("wallet status", s, statuses...)
+	return Status(v), err
+}
+
+// IsKnown reports whether s is one of the Status constants
+func (s Status) IsKnown() bool {
+	v, err := ParseStatus(string(s))
+	return err == nil && v == s
+}
+
+// UnmarshalJSON accepts any case and keeps unknown statuses as sent
+func (s *Status) UnmarshalJSON(data []byte) error {
+	v, err := common.UnmarshalEnum(data, statuses...)
+	*s = Status(v)
+	return err
 }
 
 // Wallet represents an MPC wallet
 type Wallet struct {
-	WalletID    string `json:"wallet_id"`
-	WalletName  string `json:"wallet_name"`
-	Description string `json:"description"`
-	Chain       string `json:"chain"`
-	Network     string `json:"network"`
-	WalletType  string `json:"wallet_type"` // MPC
-	Status      string `json:"status"`      // PENDING, ACTIVE, FROZEN, DELETED
-	CreatedAt   string `json:"created_at"`
-	UpdatedAt   string `json:"updated_at,omitempty"`
+	WalletID    string         `json:"wallet_id"`
+	WalletName  string         `json:"wallet_name"`
+	Description string         `json:"description"`
+	Chain       common.Chain   `json:"chain"`
+	Network     common.Network `json:"network"`
+	WalletType  string         `json:"wallet_type"` // MPC
+	Status      Status         `json:"status"`
+	CreatedAt   common.Time    `json:"created_at"`
+	UpdatedAt   common.Time    `json:"updated_at"`
 }
 
 // Create creates a new MPC wallet
@@ -64,7 +96,7 @@ func (s *Service) Get(ctx context.Context, walletID string) (*Wallet, error) {
 type ListWalletsRequest struct {
 	Page     int    `json:"page,omitempty"`
 	PageSize int    `json:"page_size,omitempty"`
-	Status   string `json:"status,omitempty"`
+	Status   Status `json:"status,omitempty"`
 }
 
 // ListWalletsResponse represents a paginated list of wallets
@@ -84,7 +116,7 @@ func (s *Service) List(ctx context.Context, req *ListWalletsRequest) (*ListWalle
 			params["page_size"] = strconv.Itoa(req.PageSize)
 		}
 		if req.Status != "" {
-			params["status"] = req.Status
+			params["status"] = string(req.Status)
 		}
 	}
 